@@ -0,0 +1,3 @@
+data "openwrt_dhcp_cname" "www" {
+  id = "www"
+}
@@ -0,0 +1,3 @@
+data "openwrt_dhcp_match" "efi64" {
+  id = "efi64"
+}
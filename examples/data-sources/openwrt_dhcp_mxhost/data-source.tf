@@ -0,0 +1,3 @@
+data "openwrt_dhcp_mxhost" "testing" {
+  id = "testing"
+}
@@ -0,0 +1,3 @@
+data "openwrt_dhcp_srvhost" "ldap" {
+  id = "ldap"
+}
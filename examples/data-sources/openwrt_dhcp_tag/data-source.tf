@@ -0,0 +1,3 @@
+data "openwrt_dhcp_tag" "voip" {
+  id = "voip"
+}
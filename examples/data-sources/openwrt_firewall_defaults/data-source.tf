@@ -0,0 +1,2 @@
+data "openwrt_firewall_defaults" "this" {
+}
@@ -0,0 +1,3 @@
+data "openwrt_firewall_include" "testing" {
+  id = "testing"
+}
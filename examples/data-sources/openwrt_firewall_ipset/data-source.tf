@@ -0,0 +1,3 @@
+data "openwrt_firewall_ipset" "testing" {
+  id = "testing"
+}
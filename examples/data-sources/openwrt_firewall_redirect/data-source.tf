@@ -0,0 +1,3 @@
+data "openwrt_firewall_redirect" "testing" {
+  id = "testing"
+}
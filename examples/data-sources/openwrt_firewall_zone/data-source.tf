@@ -0,0 +1,3 @@
+data "openwrt_firewall_zone" "testing" {
+  id = "testing"
+}
@@ -0,0 +1,3 @@
+data "openwrt_network_bridge_vlan" "guest" {
+  id = "guest"
+}
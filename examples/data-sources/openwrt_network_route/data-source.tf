@@ -0,0 +1,3 @@
+data "openwrt_network_route" "testing" {
+  id = "testing"
+}
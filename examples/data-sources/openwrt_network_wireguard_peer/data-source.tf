@@ -0,0 +1,3 @@
+data "openwrt_network_wireguard_peer" "laptop" {
+  id = "laptop"
+}
@@ -0,0 +1,13 @@
+resource "openwrt_network_interface" "wg0" {
+  id          = "wg0"
+  private_key = "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="
+  proto       = "wireguard"
+}
+
+data "openwrt_network_wireguard_public_key" "wg0" {
+  private_key = openwrt_network_interface.wg0.private_key
+}
+
+output "wg0_public_key" {
+  value = data.openwrt_network_wireguard_public_key.wg0.public_key
+}
@@ -0,0 +1,10 @@
+data "openwrt_wireless_assoclist" "wlan0" {
+  device = "wlan0"
+}
+
+output "associated_stations" {
+  value = {
+    for station in data.openwrt_wireless_assoclist.wlan0.stations :
+    station.mac => station.signal
+  }
+}
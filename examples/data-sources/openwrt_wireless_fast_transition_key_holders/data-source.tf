@@ -0,0 +1,28 @@
+data "openwrt_wireless_fast_transition_key_holders" "office" {
+  access_points = [
+    {
+      mac   = "00:11:22:33:44:55"
+      nasid = "ap1"
+    },
+    {
+      mac   = "00:11:22:33:44:66"
+      nasid = "ap2"
+    },
+  ]
+  key = "00112233445566778899aabbccddeeff"
+}
+
+resource "openwrt_wireless_wifi_iface" "office" {
+  device          = "radio0"
+  encryption      = "psk2"
+  id              = "office"
+  ieee80211r      = true
+  key             = "password"
+  mobility_domain = "4f57"
+  mode            = "ap"
+  nasid           = "ap1"
+  network         = "lan"
+  r0kh            = data.openwrt_wireless_fast_transition_key_holders.office.r0kh
+  r1kh            = data.openwrt_wireless_fast_transition_key_holders.office.r1kh
+  ssid            = "office"
+}
@@ -0,0 +1,10 @@
+data "openwrt_wireless_scan" "wlan0" {
+  device = "wlan0"
+}
+
+output "nearby_channels" {
+  value = distinct([
+    for network in data.openwrt_wireless_scan.wlan0.networks :
+    network.channel
+  ])
+}
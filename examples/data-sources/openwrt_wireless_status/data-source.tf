@@ -0,0 +1,6 @@
+data "openwrt_wireless_status" "this" {
+}
+
+output "radio0_channel" {
+  value = data.openwrt_wireless_status.this.radios["radio0"].channel
+}
@@ -0,0 +1,3 @@
+data "openwrt_wireless_wifi_station" "thermostat" {
+  id = "thermostat"
+}
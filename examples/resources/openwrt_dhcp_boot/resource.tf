@@ -0,0 +1,7 @@
+resource "openwrt_dhcp_boot" "lab" {
+  filename      = "pxelinux.0"
+  id            = "lab"
+  networkid     = "lab"
+  serveraddress = "192.168.1.2"
+  servername    = "tftp"
+}
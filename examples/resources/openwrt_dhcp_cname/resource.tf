@@ -0,0 +1,11 @@
+resource "openwrt_dhcp_domain" "web" {
+  id   = "web"
+  ip   = "192.168.1.50"
+  name = "web.testing"
+}
+
+resource "openwrt_dhcp_cname" "www" {
+  cname  = "www.testing"
+  id     = "www"
+  target = openwrt_dhcp_domain.web.name
+}
@@ -22,6 +22,10 @@ resource "openwrt_network_interface" "testing" {
 }
 
 resource "openwrt_dhcp_dhcp" "testing" {
+  dhcp_option = {
+    "option:ntp-server" = "192.168.3.1"
+    "tag:voip,43"       = "01:04:c0:a8:03:0a"
+  }
   dhcpv4    = "server"
   dhcpv6    = "server"
   id        = "testing"
@@ -1,8 +1,20 @@
 resource "openwrt_dhcp_dnsmasq" "this" {
+  address = [
+    "/router.testing/192.168.1.1",
+  ]
+  cachesize         = 1000
+  dhcp_boot         = "pxelinux.0"
   domain            = "testing"
+  enable_tftp       = true
   expandhosts       = true
   id                = "testing"
   local             = "/testing/"
+  noresolv          = true
   rebind_localhost  = true
   rebind_protection = true
+  server = [
+    "9.9.9.9",
+    "1.1.1.1",
+  ]
+  tftp_root = "/srv/tftp"
 }
@@ -1,6 +1,21 @@
 resource "openwrt_dhcp_host" "testing" {
-  id   = "testing"
-  ip   = "192.168.1.50"
-  mac  = "12:34:56:78:90:ab"
+  id = "testing"
+  ip = "192.168.1.50"
+  mac = [
+    "12:34:56:78:90:ab",
+  ]
   name = "testing"
 }
+
+resource "openwrt_dhcp_host" "laptop" {
+  duid      = "00010001a1b2c3d4001122334455"
+  hostid    = "50"
+  id        = "laptop"
+  ip        = "192.168.1.51"
+  leasetime = "infinite"
+  mac = [
+    "12:34:56:78:90:cd",
+    "12:34:56:78:90:ef",
+  ]
+  name = "laptop"
+}
@@ -0,0 +1,8 @@
+resource "openwrt_dhcp_match" "efi64" {
+  dhcp_option = {
+    "option:bootfile-name" = "ipxe.efi"
+  }
+  id        = "efi64"
+  match     = "option:client-arch,7"
+  networkid = "efi64"
+}
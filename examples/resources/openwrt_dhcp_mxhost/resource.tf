@@ -0,0 +1,6 @@
+resource "openwrt_dhcp_mxhost" "testing" {
+  domain = "testing"
+  id     = "testing"
+  pref   = 10
+  relay  = "mail.testing"
+}
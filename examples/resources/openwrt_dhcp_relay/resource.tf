@@ -0,0 +1,6 @@
+resource "openwrt_dhcp_relay" "branch" {
+  id          = "branch"
+  interface   = "wan"
+  local_addr  = "192.168.1.1"
+  server_addr = "10.0.0.2"
+}
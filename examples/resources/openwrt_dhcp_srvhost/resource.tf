@@ -0,0 +1,8 @@
+resource "openwrt_dhcp_srvhost" "ldap" {
+  class  = 10
+  id     = "ldap"
+  port   = 389
+  srv    = "_ldap._tcp.testing"
+  target = "ldap.testing"
+  weight = 50
+}
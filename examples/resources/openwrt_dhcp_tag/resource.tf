@@ -0,0 +1,15 @@
+resource "openwrt_dhcp_host" "phone" {
+  id   = "phone"
+  ip   = "192.168.1.60"
+  mac  = ["12:34:56:78:90:ab"]
+  name = "phone"
+  tag  = ["voip"]
+}
+
+resource "openwrt_dhcp_tag" "voip" {
+  dhcp_option = {
+    "42" = "192.168.1.2"
+    "43" = "01:04:c0:a8:01:0a"
+  }
+  id = "voip"
+}
@@ -0,0 +1,7 @@
+# There should only be one `firewall.defaults` config.
+# Creating the resource adopts it, so importing is only needed to bring it into state without changing anything.
+# It is usually an anonymous section, so it's imported by its position.
+# Since UCI changes the name of an anonymous section whenever it changes,
+# the section is given a generated name (e.g. "defaults_1a2b3c4d") on the first apply after it's imported.
+
+terraform import openwrt_firewall_defaults.this '@defaults[0]'
@@ -0,0 +1,8 @@
+resource "openwrt_firewall_defaults" "this" {
+  drop_invalid    = true
+  flow_offloading = true
+  forward         = "REJECT"
+  input           = "REJECT"
+  output          = "ACCEPT"
+  syn_flood       = true
+}
@@ -0,0 +1,20 @@
+resource "openwrt_firewall_zone" "lan" {
+  id      = "lan"
+  input   = "ACCEPT"
+  name    = "lan"
+  network = ["lan"]
+}
+
+resource "openwrt_firewall_zone" "wan" {
+  id      = "wan"
+  input   = "REJECT"
+  masq    = true
+  name    = "wan"
+  network = ["wan"]
+}
+
+resource "openwrt_firewall_forwarding" "lan_wan" {
+  dest = openwrt_firewall_zone.wan.name
+  id   = "lan_wan"
+  src  = openwrt_firewall_zone.lan.name
+}
@@ -0,0 +1,33 @@
+# Find the Terraform id from LuCI's JSON-RPC API.
+# One way to find this information is with `curl` and `jq`:
+#
+# curl \
+#     --data '{"id": 0, "method": "foreach", "params": ["firewall", "include"]}' \
+#     http://192.168.1.1/cgi-bin/luci/rpc/uci?auth=$AUTH_TOKEN \
+#     | jq '.result | map({anonymous: .[".anonymous"], name: .[".name"], path: .path})'
+#
+# This command will output something like:
+#
+# [
+#   {
+#     "anonymous": true,
+#     "name": "cfg0c92bd",
+#     "path": "/etc/firewall.user",
+#   },
+#   {
+#     "anonymous": false,
+#     "name": "input_wan",
+#     "path": "/etc/nftables.d/input_wan.nft",
+#   }
+# ]
+#
+# We'd then use the information to import the appropriate resource.
+# Named sections are imported by their name:
+
+terraform import openwrt_firewall_include.input_wan input_wan
+
+# Anonymous sections are imported by their position (starting from 0).
+# Since UCI changes the name of an anonymous section whenever it changes,
+# the section is given a generated name (e.g. "include_1a2b3c4d") on the first apply after it's imported:
+
+terraform import openwrt_firewall_include.firewall_user '@include[0]'
@@ -0,0 +1,7 @@
+resource "openwrt_firewall_include" "input_wan" {
+  chain    = "input_wan"
+  id       = "input_wan"
+  path     = "/etc/nftables.d/input_wan.nft"
+  position = "chain-pre"
+  type     = "nftables"
+}
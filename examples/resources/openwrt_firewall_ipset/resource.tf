@@ -0,0 +1,9 @@
+resource "openwrt_firewall_ipset" "management" {
+  entry = [
+    "192.168.1.10",
+    "192.168.1.11",
+  ]
+  id    = "management"
+  match = ["src_ip"]
+  name  = "management"
+}
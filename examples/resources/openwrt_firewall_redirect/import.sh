@@ -0,0 +1,33 @@
+# Find the Terraform id from LuCI's JSON-RPC API.
+# One way to find this information is with `curl` and `jq`:
+#
+# curl \
+#     --data '{"id": 0, "method": "foreach", "params": ["firewall", "redirect"]}' \
+#     http://192.168.1.1/cgi-bin/luci/rpc/uci?auth=$AUTH_TOKEN \
+#     | jq '.result | map({anonymous: .[".anonymous"], name: .[".name"], redirect: .name})'
+#
+# This command will output something like:
+#
+# [
+#   {
+#     "anonymous": true,
+#     "name": "cfg0a92bd",
+#     "redirect": "Forward-SSH",
+#   },
+#   {
+#     "anonymous": false,
+#     "name": "web_server",
+#     "redirect": "Forward-HTTP",
+#   }
+# ]
+#
+# We'd then use the information to import the appropriate resource.
+# Named sections are imported by their name:
+
+terraform import openwrt_firewall_redirect.web_server web_server
+
+# Anonymous sections are imported by their position (starting from 0).
+# Since UCI changes the name of an anonymous section whenever it changes,
+# the section is given a generated name (e.g. "redirect_1a2b3c4d") on the first apply after it's imported:
+
+terraform import openwrt_firewall_redirect.forward_ssh '@redirect[0]'
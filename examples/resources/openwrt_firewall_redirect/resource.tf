@@ -0,0 +1,11 @@
+resource "openwrt_firewall_redirect" "web_server" {
+  dest      = "lan"
+  dest_ip   = "192.168.1.10"
+  dest_port = "80"
+  id        = "web_server"
+  name      = "Forward-HTTP"
+  proto     = ["tcp"]
+  src       = "wan"
+  src_dport = "8080"
+  target    = "DNAT"
+}
@@ -0,0 +1,33 @@
+# Find the Terraform id from LuCI's JSON-RPC API.
+# One way to find this information is with `curl` and `jq`:
+#
+# curl \
+#     --data '{"id": 0, "method": "foreach", "params": ["firewall", "rule"]}' \
+#     http://192.168.1.1/cgi-bin/luci/rpc/uci?auth=$AUTH_TOKEN \
+#     | jq '.result | map({anonymous: .[".anonymous"], name: .[".name"], rule: .name})'
+#
+# This command will output something like:
+#
+# [
+#   {
+#     "anonymous": true,
+#     "name": "cfg0a92bd",
+#     "rule": "Allow-DHCP-Renew",
+#   },
+#   {
+#     "anonymous": false,
+#     "name": "allow_ssh",
+#     "rule": "Allow-SSH",
+#   }
+# ]
+#
+# We'd then use the information to import the appropriate resource.
+# Named sections are imported by their name:
+
+terraform import openwrt_firewall_rule.allow_ssh allow_ssh
+
+# Anonymous sections are imported by their position (starting from 0).
+# Since UCI changes the name of an anonymous section whenever it changes,
+# the section is given a generated name (e.g. "rule_1a2b3c4d") on the first apply after it's imported:
+
+terraform import openwrt_firewall_rule.allow_dhcp_renew '@rule[0]'
@@ -0,0 +1,23 @@
+resource "openwrt_firewall_rule" "allow_ssh_from_management" {
+  dest_port = "22"
+  name      = "Allow-SSH-Management"
+  proto     = ["tcp"]
+  src       = "management"
+  target    = "ACCEPT"
+}
+
+resource "openwrt_firewall_rule" "allow_icmpv6_input" {
+  family = "ipv6"
+  icmp_type = [
+    "echo-reply",
+    "echo-request",
+    "neighbour-advertisement",
+    "neighbour-solicitation",
+  ]
+  id     = "allow_icmpv6_input"
+  limit  = "1000/second"
+  name   = "Allow-ICMPv6-Input"
+  proto  = ["icmp"]
+  src    = "wan"
+  target = "ACCEPT"
+}
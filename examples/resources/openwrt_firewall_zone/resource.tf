@@ -0,0 +1,18 @@
+resource "openwrt_network_interface" "testing" {
+  device  = "br-testing"
+  id      = "testing"
+  ipaddr  = "192.168.3.1"
+  netmask = "255.255.255.0"
+  proto   = "static"
+}
+
+resource "openwrt_firewall_zone" "testing" {
+  forward = "REJECT"
+  id      = "testing"
+  input   = "ACCEPT"
+  name    = "testing"
+  network = [
+    openwrt_network_interface.testing.id,
+  ]
+  output = "ACCEPT"
+}
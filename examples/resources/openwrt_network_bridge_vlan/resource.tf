@@ -0,0 +1,26 @@
+resource "openwrt_network_device" "br_lan" {
+  id   = "br_lan"
+  name = "br-lan"
+  ports = [
+    "lan1",
+    "lan2",
+    "lan3",
+  ]
+  type = "bridge"
+}
+
+resource "openwrt_network_bridge_vlan" "guest" {
+  device = openwrt_network_device.br_lan.name
+  id     = "guest"
+  ports = [
+    {
+      name    = "lan1"
+      primary = true
+    },
+    {
+      name   = "lan3"
+      tagged = true
+    },
+  ]
+  vlan = 20
+}
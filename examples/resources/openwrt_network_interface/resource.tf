@@ -20,3 +20,32 @@ resource "openwrt_network_interface" "testing" {
   netmask = "255.255.255.0"
   proto   = "static"
 }
+
+variable "wireguard_private_key" {
+  sensitive = true
+  type      = string
+}
+
+resource "openwrt_network_interface" "wg0" {
+  addresses = [
+    "10.0.0.1/24",
+  ]
+  id          = "wg0"
+  listen_port = 51820
+  private_key = var.wireguard_private_key
+  proto       = "wireguard"
+}
+
+variable "pppoe_password" {
+  sensitive = true
+  type      = string
+}
+
+resource "openwrt_network_interface" "wan" {
+  device    = "eth1"
+  id        = "wan"
+  keepalive = "5 1"
+  password  = var.pppoe_password
+  proto     = "pppoe"
+  username  = "customer@isp.example"
+}
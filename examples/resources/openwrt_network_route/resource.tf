@@ -0,0 +1,7 @@
+resource "openwrt_network_route" "branch_office" {
+  gateway   = "192.168.1.2"
+  id        = "branch_office"
+  interface = "lan"
+  metric    = 10
+  target    = "10.20.0.0/16"
+}
@@ -0,0 +1,7 @@
+resource "openwrt_network_route6" "branch_office" {
+  gateway   = "fe80::2"
+  id        = "branch_office"
+  interface = "lan"
+  metric    = 10
+  target    = "fd00:20::/48"
+}
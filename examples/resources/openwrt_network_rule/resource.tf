@@ -0,0 +1,7 @@
+resource "openwrt_network_rule" "vpn_clients" {
+  id       = "vpn_clients"
+  in       = "lan"
+  lookup   = "100"
+  priority = 1000
+  src      = "192.168.2.0/24"
+}
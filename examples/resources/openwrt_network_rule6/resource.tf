@@ -0,0 +1,7 @@
+resource "openwrt_network_rule6" "vpn_clients" {
+  id       = "vpn_clients"
+  in       = "lan"
+  lookup   = "100"
+  priority = 1000
+  src      = "fd00:2::/64"
+}
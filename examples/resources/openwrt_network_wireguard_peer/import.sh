@@ -0,0 +1,34 @@
+# Find the Terraform id from LuCI's JSON-RPC API.
+# Peers have the UCI type `wireguard_<interface>`.
+# One way to find this information is with `curl` and `jq`:
+#
+# curl \
+#     --data '{"id": 0, "method": "foreach", "params": ["network", "wireguard_wg0"]}' \
+#     http://192.168.1.1/cgi-bin/luci/rpc/uci?auth=$AUTH_TOKEN \
+#     | jq '.result | map({anonymous: .[".anonymous"], name: .[".name"], description: .description})'
+#
+# This command will output something like:
+#
+# [
+#   {
+#     "anonymous": true,
+#     "name": "cfg0a8f3e",
+#     "description": "phone",
+#   },
+#   {
+#     "anonymous": false,
+#     "name": "laptop",
+#     "description": "laptop",
+#   }
+# ]
+#
+# We'd then use the information to import the appropriate resource.
+# Named sections are imported by their name:
+
+terraform import openwrt_network_wireguard_peer.laptop laptop
+
+# Anonymous sections are imported by their position (starting from 0).
+# Since UCI changes the name of an anonymous section whenever it changes,
+# the section is given a generated name (e.g. "wireguard_peer_1a2b3c4d") on the first apply after it's imported:
+
+terraform import openwrt_network_wireguard_peer.phone '@wireguard_wg0[0]'
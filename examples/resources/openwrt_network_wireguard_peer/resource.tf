@@ -0,0 +1,10 @@
+resource "openwrt_network_wireguard_peer" "laptop" {
+  allowed_ips = [
+    "10.0.0.2/32",
+  ]
+  description          = "laptop"
+  id                   = "laptop"
+  interface            = "wg0"
+  persistent_keepalive = 25
+  public_key           = "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="
+}
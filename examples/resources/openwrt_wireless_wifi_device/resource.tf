@@ -4,3 +4,13 @@ resource "openwrt_wireless_wifi_device" "five_ghz" {
   id      = "cfg123456"
   type    = "mac80211"
 }
+
+resource "openwrt_wireless_wifi_device" "two_ghz" {
+  band    = "2g"
+  channel = "6"
+  country = "US"
+  htmode  = "HE20"
+  id      = "cfg234567"
+  txpower = 17
+  type    = "mac80211"
+}
@@ -27,3 +27,28 @@ resource "openwrt_wireless_wifi_iface" "home" {
   ssid                          = "home"
   wpa_disable_eapol_key_retries = true
 }
+
+resource "openwrt_wireless_wifi_iface" "office" {
+  acct_secret  = "accounting-secret"
+  acct_server  = "192.168.3.10"
+  auth_secret  = "authentication-secret"
+  auth_server  = "192.168.3.10"
+  device       = openwrt_wireless_wifi_device.five_ghz.id
+  dynamic_vlan = 2
+  encryption   = "wpa2"
+  id           = "wifinet1"
+  mode         = "ap"
+  network      = openwrt_network_interface.home.id
+  ssid         = "office"
+}
+
+resource "openwrt_wireless_wifi_iface" "backhaul" {
+  device      = openwrt_wireless_wifi_device.five_ghz.id
+  encryption  = "sae"
+  id          = "wifinet2"
+  key         = "password"
+  mesh_fwding = true
+  mesh_id     = "backhaul"
+  mode        = "mesh"
+  network     = openwrt_network_interface.home.id
+}
@@ -0,0 +1,35 @@
+# Find the Terraform id from LuCI's JSON-RPC API.
+# One way to find this information is with `curl` and `jq`:
+#
+# curl \
+#     --data '{"id": 0, "method": "foreach", "params": ["wireless", "wifi-station"]}' \
+#     http://192.168.1.1/cgi-bin/luci/rpc/uci?auth=$AUTH_TOKEN \
+#     | jq '.result | map({anonymous: .[".anonymous"], name: .[".name"], mac: .mac, vid: .vid})'
+#
+# This command will output something like:
+#
+# [
+#   {
+#     "anonymous": true,
+#     "name": "cfg0c92bd",
+#     "mac": "00:11:22:33:44:66",
+#     "vid": "100",
+#   },
+#   {
+#     "anonymous": false,
+#     "name": "thermostat",
+#     "mac": "00:11:22:33:44:55",
+#     "vid": "100",
+#   }
+# ]
+#
+# We'd then use the information to import the appropriate resource.
+# Named sections are imported by their name:
+
+terraform import openwrt_wireless_wifi_station.thermostat thermostat
+
+# Anonymous sections are imported by their position (starting from 0).
+# Since UCI changes the name of an anonymous section whenever it changes,
+# the section is given a generated name (e.g. "wifi_station_1a2b3c4d") on the first apply after it's imported:
+
+terraform import openwrt_wireless_wifi_station.camera '@wifi-station[0]'
@@ -0,0 +1,7 @@
+resource "openwrt_wireless_wifi_station" "thermostat" {
+  iface = "default_radio0"
+  id    = "thermostat"
+  key   = "thermostat-password"
+  mac   = "00:11:22:33:44:55"
+  vid   = 100
+}
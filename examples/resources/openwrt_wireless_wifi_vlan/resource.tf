@@ -0,0 +1,15 @@
+resource "openwrt_network_interface" "iot" {
+  device  = "br-iot"
+  id      = "iot"
+  ipaddr  = "192.168.100.1"
+  netmask = "255.255.255.0"
+  proto   = "static"
+}
+
+resource "openwrt_wireless_wifi_vlan" "iot" {
+  id      = "iot"
+  iface   = "default_radio0"
+  name    = "iot"
+  network = openwrt_network_interface.iot.id
+  vid     = 100
+}
@@ -3,10 +3,14 @@ package acceptancetest
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -31,6 +35,8 @@ const (
 	acceptanceTestDockerTag        = "acceptance-test"
 
 	dockerContainerHealthy = "healthy"
+
+	standInAuthToken = "stand-in"
 )
 
 var (
@@ -124,6 +130,70 @@ func RunOpenWrtServer(
 	}
 }
 
+// RunStandInServer constructs a stand-in for an OpenWrt server that responds with canned data.
+// Any credentials are accepted.
+// Each ubus call is answered with the result returned by handleUbusCall,
+// which should be a JSON array of the ubus status followed by the data (e.g. `[0, {}]`).
+// This is useful for state that the OpenWrt server cannot produce on its own (e.g. anything needing radios).
+func RunStandInServer(
+	t *testing.T,
+	handleUbusCall func(object string, method string, arguments map[string]any) string,
+) OpenWrtServer {
+	t.Helper()
+
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cgi-bin/luci/rpc/auth":
+			fmt.Fprintf(w, `{"id": 1, "result": %q, "error": null}`, standInAuthToken)
+
+		case "/ubus":
+			var requestBody struct {
+				Params []json.RawMessage `json:"params"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&requestBody)
+			if err != nil || len(requestBody.Params) != 4 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			var (
+				arguments map[string]any
+				method    string
+				object    string
+			)
+			err = errors.Join(
+				json.Unmarshal(requestBody.Params[1], &object),
+				json.Unmarshal(requestBody.Params[2], &method),
+				json.Unmarshal(requestBody.Params[3], &arguments),
+			)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			result := handleUbusCall(object, method, arguments)
+			fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": 1, "result": %s}`, result)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+	server := httptest.NewServer(http.HandlerFunc(handle))
+	t.Cleanup(server.Close)
+	address, err := url.Parse(server.URL)
+	assert.NilError(t, err)
+	port, err := strconv.Atoi(address.Port())
+	assert.NilError(t, err)
+
+	return OpenWrtServer{
+		Hostname: address.Hostname(),
+		HTTPPort: uint16(port),
+		Password: "",
+		Scheme:   address.Scheme,
+		Username: "root",
+	}
+}
+
 // Setup does a bit of setup so acceptance tests can run:
 //  1. Connect to a running docker daemon.
 //  2. Build and tag the image for acceptance tests.
@@ -15,6 +15,7 @@ const (
 	humanReadableDeleteSection = "delete section"
 	humanReadableGetSection    = "get section"
 	humanReadableLogin         = "login"
+	humanReadableRenameSection = "rename section"
 	humanReadableShowChanges   = "show changes"
 	humanReadableUpdateSection = "update section"
 
@@ -23,17 +24,23 @@ const (
 	methodDelete  = "delete"
 	methodGetAll  = "get_all"
 	methodLogin   = "login"
+	methodRename  = "rename"
 	methodSection = "section"
 	methodTSet    = "tset"
 
 	pathAuth = "/cgi-bin/luci/rpc/auth"
+	pathUbus = "/ubus"
 	pathUCI  = "/cgi-bin/luci/rpc/uci"
 
 	queryKeyAuth = "auth"
+
+	ubusJSONRPCVersion = "2.0"
+	ubusMethodCall     = "call"
 )
 
 type Client struct {
 	jsonRPCClientUCI jsonRPCClient
+	ubusClient       ubusClient
 }
 
 func (c *Client) CommitChanges(
@@ -263,6 +270,71 @@ func (c *Client) GetSection(
 	return result, nil
 }
 
+func (c *Client) RenameSection(
+	ctx context.Context,
+	config string,
+	section string,
+	name string,
+) (bool, error) {
+	marshalledConfig, err := json.Marshal(config)
+	if err != nil {
+		return false, fmt.Errorf("unable to serialize config %q for %s: %w", config, humanReadableRenameSection, err)
+	}
+
+	marshalledSection, err := json.Marshal(section)
+	if err != nil {
+		return false, fmt.Errorf("unable to serialize section %q for %s: %w", section, humanReadableRenameSection, err)
+	}
+
+	marshalledName, err := json.Marshal(name)
+	if err != nil {
+		return false, fmt.Errorf("unable to serialize name %q for %s: %w", name, humanReadableRenameSection, err)
+	}
+
+	requestBody := jsonRPCRequestBody{
+		Method: methodRename,
+		Params: []json.RawMessage{
+			marshalledConfig,
+			marshalledSection,
+			marshalledName,
+		},
+	}
+	responseBody, err := c.jsonRPCClientUCI.Invoke(
+		ctx,
+		humanReadableRenameSection,
+		requestBody,
+	)
+	if err != nil {
+		return false, fmt.Errorf("unable to %s: %w", humanReadableRenameSection, err)
+	}
+
+	// The result can be `true` to indicate success,
+	// or `null` to indicate failure.
+	var result bool
+	if responseBody == nil {
+		return false, nil
+	}
+
+	err = json.Unmarshal(*responseBody, &result)
+	if err != nil {
+		return false, fmt.Errorf("unable to parse %s response: %w", humanReadableRenameSection, err)
+	}
+
+	if !result {
+		return false, fmt.Errorf("unable to %s: it is not clear why this happened", humanReadableRenameSection)
+	}
+
+	result, err = c.CommitChanges(
+		ctx,
+		config,
+	)
+	if err != nil {
+		return false, fmt.Errorf("was able to %s, but could not %s: %w", humanReadableRenameSection, humanReadableCommitChanges, err)
+	}
+
+	return result, nil
+}
+
 func (c *Client) ShowChanges(
 	ctx context.Context,
 	config string,
@@ -432,8 +504,21 @@ func NewClient(
 		*httpClient,
 		addressUCI,
 	)
+	// The auth token is also a ubus session,
+	// so we can use it to call ubus directly.
+	addressUbus := url.URL{
+		Host:   host,
+		Path:   pathUbus,
+		Scheme: scheme,
+	}
+	ubusClient := ubusNewClient(
+		*httpClient,
+		addressUbus,
+		authToken,
+	)
 	client := &Client{
 		jsonRPCClientUCI: jsonRPCClientUCI,
+		ubusClient:       ubusClient,
 	}
 	return client, nil
 }
@@ -529,3 +614,130 @@ type jsonRPCResponseBody struct {
 	Error  *string          `json:"error"`
 	Result *json.RawMessage `json:"result"`
 }
+
+type ubusClient struct {
+	address url.URL
+	client  http.Client
+	session string
+}
+
+// Call invokes a ubus method through the JSON-RPC interface of uhttpd.
+// It returns the data of the response,
+// which is `nil` if the method does not respond with any data.
+func (c ubusClient) Call(
+	ctx context.Context,
+	humanReadableMethod string,
+	object string,
+	method string,
+	arguments map[string]any,
+) (*json.RawMessage, error) {
+	if arguments == nil {
+		arguments = map[string]any{}
+	}
+
+	params := []any{
+		c.session,
+		object,
+		method,
+		arguments,
+	}
+	marshalledParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize params for %s: %w", humanReadableMethod, err)
+	}
+
+	requestBody := ubusRequestBody{
+		Id:      1,
+		JSONRPC: ubusJSONRPCVersion,
+		Method:  ubusMethodCall,
+		Params:  marshalledParams,
+	}
+	buffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&buffer)
+	err = encoder.Encode(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding %s request: %w", humanReadableMethod, err)
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.address.String(),
+		&buffer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating %s request: %w", humanReadableMethod, err)
+	}
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("problem sending request to %s: %w", humanReadableMethod, err)
+	}
+
+	defer response.Body.Close()
+	if response.StatusCode != 200 {
+		return nil, fmt.Errorf("expected %s to respond with a 200: got %s", humanReadableMethod, response.Status)
+	}
+
+	var responseBody ubusResponseBody
+	decoder := json.NewDecoder(response.Body)
+	err = decoder.Decode(&responseBody)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s response: %w", humanReadableMethod, err)
+	}
+
+	if responseBody.Error != nil {
+		return nil, fmt.Errorf("%s error: %s", humanReadableMethod, responseBody.Error.Message)
+	}
+
+	// The result is an array of a status code,
+	// optionally followed by the data.
+	if len(responseBody.Result) == 0 {
+		return nil, fmt.Errorf("invalid %s response: expected either an error or a result, got neither", humanReadableMethod)
+	}
+
+	var status int
+	err = json.Unmarshal(responseBody.Result[0], &status)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s status: %w", humanReadableMethod, err)
+	}
+
+	if status != 0 {
+		return nil, fmt.Errorf("%s failed with ubus status %d", humanReadableMethod, status)
+	}
+
+	if len(responseBody.Result) < 2 {
+		return nil, nil
+	}
+
+	return &responseBody.Result[1], nil
+}
+
+func ubusNewClient(
+	httpClient http.Client,
+	address url.URL,
+	session string,
+) ubusClient {
+	return ubusClient{
+		address: address,
+		client:  httpClient,
+		session: session,
+	}
+}
+
+type ubusError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type ubusRequestBody struct {
+	Id      int             `json:"id"`
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type ubusResponseBody struct {
+	Error  *ubusError        `json:"error"`
+	Result []json.RawMessage `json:"result"`
+}
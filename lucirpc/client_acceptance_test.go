@@ -421,6 +421,143 @@ func TestNewClientAcceptance(t *testing.T) {
 	})
 }
 
+func TestClientRenameSectionAcceptance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns true when successful", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		ctx := context.Background()
+		openWrtServer := acceptancetest.RunOpenWrtServer(
+			ctx,
+			*dockerPool,
+			t,
+		)
+		client := openWrtServer.LuCIRPCClient(
+			ctx,
+			t,
+		)
+		_, err := client.CreateSection(
+			ctx,
+			"network",
+			"interface",
+			"testing",
+			lucirpc.Options{},
+		)
+		assert.NilError(t, err)
+
+		// When
+		got, err := client.RenameSection(
+			ctx,
+			"network",
+			"testing",
+			"renamed",
+		)
+
+		// Then
+		assert.NilError(t, err)
+		assert.Check(t, got)
+	})
+
+	t.Run("renames the section", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		ctx := context.Background()
+		openWrtServer := acceptancetest.RunOpenWrtServer(
+			ctx,
+			*dockerPool,
+			t,
+		)
+		client := openWrtServer.LuCIRPCClient(
+			ctx,
+			t,
+		)
+		_, err := client.CreateSection(
+			ctx,
+			"network",
+			"interface",
+			"testing",
+			lucirpc.Options{
+				"proto": lucirpc.String("static"),
+			},
+		)
+		assert.NilError(t, err)
+
+		// When
+		_, err = client.RenameSection(
+			ctx,
+			"network",
+			"testing",
+			"renamed",
+		)
+
+		// Then
+		assert.NilError(t, err)
+		_, err = client.GetSection(
+			ctx,
+			"network",
+			"testing",
+		)
+		assert.ErrorContains(t, err, "")
+		got, err := client.GetSection(
+			ctx,
+			"network",
+			"renamed",
+		)
+		assert.NilError(t, err)
+		want := lucirpc.Options{
+			".anonymous": lucirpc.Boolean(false),
+			".name":      lucirpc.String("renamed"),
+			".type":      lucirpc.String("interface"),
+			"proto":      lucirpc.String("static"),
+		}
+		assert.DeepEqual(t, got, want)
+	})
+
+	t.Run("does not leave pending changes when successful", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		ctx := context.Background()
+		openWrtServer := acceptancetest.RunOpenWrtServer(
+			ctx,
+			*dockerPool,
+			t,
+		)
+		client := openWrtServer.LuCIRPCClient(
+			ctx,
+			t,
+		)
+		_, err := client.CreateSection(
+			ctx,
+			"network",
+			"interface",
+			"testing",
+			lucirpc.Options{},
+		)
+		assert.NilError(t, err)
+
+		// When
+		_, err = client.RenameSection(
+			ctx,
+			"network",
+			"testing",
+			"renamed",
+		)
+
+		// Then
+		assert.NilError(t, err)
+		got, err := client.ShowChanges(
+			ctx,
+			"network",
+		)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, [][]string{})
+	})
+}
+
 func TestClientUpdateSectionAcceptance(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,223 @@
+package lucirpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	humanReadableGetWirelessInfo      = "get wireless info"
+	humanReadableGetWirelessStatus    = "get wireless status"
+	humanReadableListWirelessStations = "list wireless stations"
+	humanReadableScanWirelessNetworks = "scan wireless networks"
+
+	ubusArgumentDevice = "device"
+
+	ubusMethodAssoclist = "assoclist"
+	ubusMethodInfo      = "info"
+	ubusMethodScan      = "scan"
+	ubusMethodStatus    = "status"
+
+	ubusObjectIWInfo          = "iwinfo"
+	ubusObjectNetworkWireless = "network.wireless"
+)
+
+// WirelessEncryption is the encryption of a wireless network, as reported by iwinfo.
+type WirelessEncryption struct {
+	Authentication []string `json:"authentication"`
+	Ciphers        []string `json:"ciphers"`
+	Enabled        bool     `json:"enabled"`
+	WPA            []int    `json:"wpa"`
+}
+
+// WirelessInfo is the state of a wireless interface, as reported by iwinfo.
+type WirelessInfo struct {
+	BSSID      string `json:"bssid"`
+	Channel    int64  `json:"channel"`
+	Country    string `json:"country"`
+	Frequency  int64  `json:"frequency"`
+	Mode       string `json:"mode"`
+	Noise      int64  `json:"noise"`
+	Phy        string `json:"phy"`
+	Quality    int64  `json:"quality"`
+	QualityMax int64  `json:"quality_max"`
+	Signal     int64  `json:"signal"`
+	SSID       string `json:"ssid"`
+	TXPower    int64  `json:"txpower"`
+}
+
+// WirelessNetwork is a nearby wireless network found by a scan.
+type WirelessNetwork struct {
+	BSSID      string             `json:"bssid"`
+	Channel    int64              `json:"channel"`
+	Encryption WirelessEncryption `json:"encryption"`
+	Mode       string             `json:"mode"`
+	Quality    int64              `json:"quality"`
+	QualityMax int64              `json:"quality_max"`
+	Signal     int64              `json:"signal"`
+	SSID       string             `json:"ssid"`
+}
+
+// WirelessRadio is the state of a radio (a `wifi-device` section), as reported by netifd.
+type WirelessRadio struct {
+	Autostart  bool                     `json:"autostart"`
+	Disabled   bool                     `json:"disabled"`
+	Interfaces []WirelessRadioInterface `json:"interfaces"`
+	Pending    bool                     `json:"pending"`
+	Up         bool                     `json:"up"`
+}
+
+// WirelessRadioInterface is a wireless network (a `wifi-iface` section) on a radio.
+type WirelessRadioInterface struct {
+	Ifname  string `json:"ifname"`
+	Section string `json:"section"`
+}
+
+// WirelessRate is the rate of a direction of traffic with a station.
+type WirelessRate struct {
+	MCS     int64 `json:"mcs"`
+	MHz     int64 `json:"mhz"`
+	Packets int64 `json:"packets"`
+	Rate    int64 `json:"rate"`
+}
+
+// WirelessStation is a station associated with a wireless interface.
+type WirelessStation struct {
+	Inactive int64        `json:"inactive"`
+	MAC      string       `json:"mac"`
+	Noise    int64        `json:"noise"`
+	RX       WirelessRate `json:"rx"`
+	Signal   int64        `json:"signal"`
+	TX       WirelessRate `json:"tx"`
+}
+
+// GetWirelessInfo returns the state of the given wireless interface (e.g. "wlan0").
+func (c *Client) GetWirelessInfo(
+	ctx context.Context,
+	device string,
+) (WirelessInfo, error) {
+	responseBody, err := c.ubusClient.Call(
+		ctx,
+		humanReadableGetWirelessInfo,
+		ubusObjectIWInfo,
+		ubusMethodInfo,
+		map[string]any{
+			ubusArgumentDevice: device,
+		},
+	)
+	if err != nil {
+		return WirelessInfo{}, fmt.Errorf("unable to %s: %w", humanReadableGetWirelessInfo, err)
+	}
+
+	if responseBody == nil {
+		return WirelessInfo{}, fmt.Errorf("could not find wireless interface %s", device)
+	}
+
+	var result WirelessInfo
+	err = json.Unmarshal(*responseBody, &result)
+	if err != nil {
+		return WirelessInfo{}, fmt.Errorf("unable to parse %s response: %w", humanReadableGetWirelessInfo, err)
+	}
+
+	return result, nil
+}
+
+// GetWirelessStatus returns the state of every radio, keyed by the name of its `wifi-device` section.
+func (c *Client) GetWirelessStatus(
+	ctx context.Context,
+) (map[string]WirelessRadio, error) {
+	responseBody, err := c.ubusClient.Call(
+		ctx,
+		humanReadableGetWirelessStatus,
+		ubusObjectNetworkWireless,
+		ubusMethodStatus,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to %s: %w", humanReadableGetWirelessStatus, err)
+	}
+
+	result := map[string]WirelessRadio{}
+	if responseBody == nil {
+		return result, nil
+	}
+
+	err = json.Unmarshal(*responseBody, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s response: %w", humanReadableGetWirelessStatus, err)
+	}
+
+	return result, nil
+}
+
+// ListWirelessStations returns the stations associated with the given wireless interface (e.g. "wlan0").
+func (c *Client) ListWirelessStations(
+	ctx context.Context,
+	device string,
+) ([]WirelessStation, error) {
+	responseBody, err := c.ubusClient.Call(
+		ctx,
+		humanReadableListWirelessStations,
+		ubusObjectIWInfo,
+		ubusMethodAssoclist,
+		map[string]any{
+			ubusArgumentDevice: device,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to %s: %w", humanReadableListWirelessStations, err)
+	}
+
+	result := wirelessResults[WirelessStation]{
+		Results: []WirelessStation{},
+	}
+	if responseBody == nil {
+		return result.Results, nil
+	}
+
+	err = json.Unmarshal(*responseBody, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s response: %w", humanReadableListWirelessStations, err)
+	}
+
+	return result.Results, nil
+}
+
+// ScanWirelessNetworks returns the wireless networks the given wireless interface (e.g. "wlan0") can see.
+// The scan can take a few seconds.
+func (c *Client) ScanWirelessNetworks(
+	ctx context.Context,
+	device string,
+) ([]WirelessNetwork, error) {
+	responseBody, err := c.ubusClient.Call(
+		ctx,
+		humanReadableScanWirelessNetworks,
+		ubusObjectIWInfo,
+		ubusMethodScan,
+		map[string]any{
+			ubusArgumentDevice: device,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to %s: %w", humanReadableScanWirelessNetworks, err)
+	}
+
+	result := wirelessResults[WirelessNetwork]{
+		Results: []WirelessNetwork{},
+	}
+	if responseBody == nil {
+		return result.Results, nil
+	}
+
+	err = json.Unmarshal(*responseBody, &result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s response: %w", humanReadableScanWirelessNetworks, err)
+	}
+
+	return result.Results, nil
+}
+
+type wirelessResults[Result any] struct {
+	Results []Result `json:"results"`
+}
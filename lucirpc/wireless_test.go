@@ -0,0 +1,514 @@
+package lucirpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestClientGetWirelessInfo(t *testing.T) {
+	t.Run("makes a request to correct endpoint", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/ubus":
+				fmt.Fprintf(w, `{
+					"jsonrpc": "2.0",
+					"id": 1,
+					"result": [0, {}]
+				}`)
+
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.NilError(t, err)
+	})
+
+	t.Run("calls iwinfo with the session and device", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		var params []any
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Method string `json:"method"`
+				Params []any  `json:"params"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			assert.NilError(t, err)
+			assert.Equal(t, body.Method, "call")
+			params = body.Params
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [0, {}]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, params, []any{
+			"abc123",
+			"iwinfo",
+			"info",
+			map[string]any{
+				"device": "wlan0",
+			},
+		})
+	})
+
+	t.Run("expects a 200 response", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.ErrorContains(t, err, "expected get wireless info to respond with a 200")
+	})
+
+	t.Run("returns error when ubus responds with an error", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"error": {
+					"code": -32002,
+					"message": "Access denied"
+				}
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.ErrorContains(t, err, "get wireless info error: Access denied")
+	})
+
+	t.Run("returns error when ubus responds with a non-zero status", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [4]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.ErrorContains(t, err, "get wireless info failed with ubus status 4")
+	})
+
+	t.Run("returns error when there is no data", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [0]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.ErrorContains(t, err, "could not find wireless interface wlan0")
+	})
+
+	t.Run("parses the info", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [
+					0,
+					{
+						"phy": "phy0",
+						"ssid": "OpenWrt",
+						"bssid": "00:11:22:33:44:55",
+						"country": "US",
+						"mode": "Master",
+						"channel": 36,
+						"frequency": 5180,
+						"txpower": 20,
+						"quality": 0,
+						"quality_max": 70,
+						"signal": 0,
+						"noise": -92
+					}
+				]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		got, err := client.GetWirelessInfo(ctx, "wlan0")
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, lucirpc.WirelessInfo{
+			BSSID:      "00:11:22:33:44:55",
+			Channel:    36,
+			Country:    "US",
+			Frequency:  5180,
+			Mode:       "Master",
+			Noise:      -92,
+			Phy:        "phy0",
+			QualityMax: 70,
+			SSID:       "OpenWrt",
+			TXPower:    20,
+		})
+	})
+}
+
+func TestClientGetWirelessStatus(t *testing.T) {
+	t.Run("returns no radios when there is no data", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [0]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		got, err := client.GetWirelessStatus(ctx)
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, map[string]lucirpc.WirelessRadio{})
+	})
+
+	t.Run("parses the radios", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [
+					0,
+					{
+						"radio0": {
+							"up": true,
+							"pending": false,
+							"autostart": true,
+							"disabled": false,
+							"retry_setup_failed": false,
+							"config": {
+								"channel": "36"
+							},
+							"interfaces": [
+								{
+									"section": "default_radio0",
+									"ifname": "wlan0",
+									"config": {
+										"mode": "ap"
+									}
+								}
+							]
+						},
+						"radio1": {
+							"up": false,
+							"pending": false,
+							"autostart": false,
+							"disabled": true,
+							"interfaces": []
+						}
+					}
+				]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		got, err := client.GetWirelessStatus(ctx)
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, map[string]lucirpc.WirelessRadio{
+			"radio0": {
+				Autostart: true,
+				Interfaces: []lucirpc.WirelessRadioInterface{
+					{
+						Ifname:  "wlan0",
+						Section: "default_radio0",
+					},
+				},
+				Up: true,
+			},
+			"radio1": {
+				Disabled:   true,
+				Interfaces: []lucirpc.WirelessRadioInterface{},
+			},
+		})
+	})
+}
+
+func TestClientListWirelessStations(t *testing.T) {
+	t.Run("returns error when ubus responds with a non-zero status", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [2]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		_, err := client.ListWirelessStations(ctx, "wlan0")
+
+		// Then
+		assert.ErrorContains(t, err, "list wireless stations failed with ubus status 2")
+	})
+
+	t.Run("parses the stations", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [
+					0,
+					{
+						"results": [
+							{
+								"mac": "00:11:22:33:44:AA",
+								"signal": -45,
+								"noise": -92,
+								"inactive": 10,
+								"rx": {
+									"rate": 866700,
+									"mcs": 9,
+									"40mhz": false,
+									"short_gi": true,
+									"mhz": 80,
+									"packets": 1024
+								},
+								"tx": {
+									"rate": 780000,
+									"mcs": 8,
+									"mhz": 80,
+									"packets": 2048
+								}
+							}
+						]
+					}
+				]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		got, err := client.ListWirelessStations(ctx, "wlan0")
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, []lucirpc.WirelessStation{
+			{
+				Inactive: 10,
+				MAC:      "00:11:22:33:44:AA",
+				Noise:    -92,
+				RX: lucirpc.WirelessRate{
+					MCS:     9,
+					MHz:     80,
+					Packets: 1024,
+					Rate:    866700,
+				},
+				Signal: -45,
+				TX: lucirpc.WirelessRate{
+					MCS:     8,
+					MHz:     80,
+					Packets: 2048,
+					Rate:    780000,
+				},
+			},
+		})
+	})
+}
+
+func TestClientScanWirelessNetworks(t *testing.T) {
+	t.Run("returns no networks when there is no data", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [0]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		got, err := client.ScanWirelessNetworks(ctx, "wlan0")
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, []lucirpc.WirelessNetwork{})
+	})
+
+	t.Run("parses the networks", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		handle := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{
+				"jsonrpc": "2.0",
+				"id": 1,
+				"result": [
+					0,
+					{
+						"results": [
+							{
+								"ssid": "Neighbor",
+								"bssid": "00:11:22:33:44:BB",
+								"mode": "Master",
+								"channel": 6,
+								"signal": -70,
+								"quality": 40,
+								"quality_max": 70,
+								"encryption": {
+									"enabled": true,
+									"wpa": [2],
+									"authentication": ["psk"],
+									"ciphers": ["ccmp"]
+								}
+							}
+						]
+					}
+				]
+			}`)
+		}
+		client, close := authenticatedClient(
+			t,
+			ctx,
+			http.HandlerFunc(handle),
+		)
+		defer close()
+
+		// When
+		got, err := client.ScanWirelessNetworks(ctx, "wlan0")
+
+		// Then
+		assert.NilError(t, err)
+		assert.DeepEqual(t, got, []lucirpc.WirelessNetwork{
+			{
+				BSSID:   "00:11:22:33:44:BB",
+				Channel: 6,
+				Encryption: lucirpc.WirelessEncryption{
+					Authentication: []string{"psk"},
+					Ciphers:        []string{"ccmp"},
+					Enabled:        true,
+					WPA:            []int{2},
+				},
+				Mode:       "Master",
+				Quality:    40,
+				QualityMax: 70,
+				Signal:     -70,
+				SSID:       "Neighbor",
+			},
+		})
+	})
+}
@@ -0,0 +1,183 @@
+package boot
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/internal/dhcpoption"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	dhcpOptionAttribute            = "dhcp_option"
+	dhcpOptionAttributeDescription = "DHCP options to send to clients that boot from this server. " + dhcpoption.Description
+	dhcpOptionUCIOption            = "dhcp_option"
+
+	filenameAttribute            = "filename"
+	filenameAttributeDescription = "The file clients should boot. E.g. `pxelinux.0`."
+	filenameUCIOption            = "filename"
+
+	forceAttribute            = "force"
+	forceAttributeDescription = "Send the DHCP options even if the client does not request them. Defaults to `false`."
+	forceDefaultValue         = false
+	forceUCIOption            = "force"
+
+	networkIdAttribute            = "networkid"
+	networkIdAttributeDescription = "Only send this boot file to clients with this tag. If unset, it is sent to every client."
+	networkIdUCIOption            = "networkid"
+
+	schemaDescription = "Network boot (PXE) settings handed out to DHCP clients."
+	schemaVersion     = 0
+
+	serverAddressAttribute            = "serveraddress"
+	serverAddressAttributeDescription = "IP address of the TFTP server clients should boot from. Requires `servername`. If unset, this router is used."
+	serverAddressUCIOption            = "serveraddress"
+
+	serverNameAttribute            = "servername"
+	serverNameAttributeDescription = "Hostname of the TFTP server clients should boot from."
+	serverNameUCIOption            = "servername"
+
+	uciConfig = "dhcp"
+	uciType   = "boot"
+)
+
+var (
+	dhcpOptionSchemaAttribute = lucirpcglue.MapStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dhcpOptionAttributeDescription,
+		ReadResponse:      dhcpoption.ReadResponse(modelSetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     dhcpoption.UpsertRequest(modelGetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		Validators:        dhcpoption.Validators(),
+	}
+
+	filenameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       filenameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetFilename, filenameAttribute, filenameUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetFilename, filenameAttribute, filenameUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[^[:space:],]+$"),
+				"must not contain whitespace or commas",
+			),
+		},
+	}
+
+	forceSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(forceDefaultValue),
+		Description:       forceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetForce, forceAttribute, forceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetForce, forceAttribute, forceUCIOption),
+	}
+
+	networkIdSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       networkIdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetNetworkId, networkIdAttribute, networkIdUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetNetworkId, networkIdAttribute, networkIdUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[^[:space:],]+$"),
+				"must not contain whitespace or commas",
+			),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		dhcpOptionAttribute:               dhcpOptionSchemaAttribute,
+		filenameAttribute:                 filenameSchemaAttribute,
+		forceAttribute:                    forceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		networkIdAttribute:                networkIdSchemaAttribute,
+		serverAddressAttribute:            serverAddressSchemaAttribute,
+		serverNameAttribute:               serverNameSchemaAttribute,
+	}
+
+	serverAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       serverAddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetServerAddress, serverAddressAttribute, serverAddressUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetServerAddress, serverAddressAttribute, serverAddressUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}$`),
+				`must be a valid IP address (e.g. "192.168.1.2")`,
+			),
+			stringvalidator.AlsoRequires(path.MatchRoot(serverNameAttribute)),
+		},
+	}
+
+	serverNameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       serverNameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetServerName, serverNameAttribute, serverNameUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetServerName, serverNameAttribute, serverNameUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[^[:space:],]+$"),
+				"must not contain whitespace or commas",
+			),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	DHCPOption    types.Map    `tfsdk:"dhcp_option"`
+	ExtraOptions  types.Map    `tfsdk:"extra_options"`
+	Filename      types.String `tfsdk:"filename"`
+	Force         types.Bool   `tfsdk:"force"`
+	Id            types.String `tfsdk:"id"`
+	NetworkId     types.String `tfsdk:"networkid"`
+	ServerAddress types.String `tfsdk:"serveraddress"`
+	ServerName    types.String `tfsdk:"servername"`
+}
+
+func modelGetDHCPOption(m model) types.Map       { return m.DHCPOption }
+func modelGetExtraOptions(m model) types.Map     { return m.ExtraOptions }
+func modelGetFilename(m model) types.String      { return m.Filename }
+func modelGetForce(m model) types.Bool           { return m.Force }
+func modelGetId(m model) types.String            { return m.Id }
+func modelGetNetworkId(m model) types.String     { return m.NetworkId }
+func modelGetServerAddress(m model) types.String { return m.ServerAddress }
+func modelGetServerName(m model) types.String    { return m.ServerName }
+
+func modelSetDHCPOption(m *model, value types.Map)       { m.DHCPOption = value }
+func modelSetExtraOptions(m *model, value types.Map)     { m.ExtraOptions = value }
+func modelSetFilename(m *model, value types.String)      { m.Filename = value }
+func modelSetForce(m *model, value types.Bool)           { m.Force = value }
+func modelSetId(m *model, value types.String)            { m.Id = value }
+func modelSetNetworkId(m *model, value types.String)     { m.NetworkId = value }
+func modelSetServerAddress(m *model, value types.String) { m.ServerAddress = value }
+func modelSetServerName(m *model, value types.String)    { m.ServerName = value }
@@ -0,0 +1,189 @@
+//go:build acceptance.test
+
+package boot_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"filename":      lucirpc.String("pxelinux.0"),
+		"networkid":     lucirpc.String("lab"),
+		"serveraddress": lucirpc.String("192.168.1.2"),
+		"servername":    lucirpc.String("tftp"),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "boot", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_boot" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_boot.testing", "filename", "pxelinux.0"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_boot.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_boot.testing", "networkid", "lab"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_boot.testing", "serveraddress", "192.168.1.2"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_boot.testing", "servername", "tftp"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_boot" "testing" {
+	filename = "pxelinux.0"
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_boot.testing", "dhcp_option"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "filename", "pxelinux.0"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "force", "false"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_boot.testing", "networkid"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_boot.testing", "serveraddress"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_boot.testing", "servername"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_boot.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_boot" "testing" {
+	dhcp_option = {
+		"option:root-path" = "192.168.1.2:/srv/nfs"
+	}
+	filename = "lpxelinux.0"
+	force = true
+	id = "testing"
+	networkid = "lab"
+	serveraddress = "192.168.1.2"
+	servername = "tftp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "dhcp_option.%", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "dhcp_option.option:root-path", "192.168.1.2:/srv/nfs"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "filename", "lpxelinux.0"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "force", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "networkid", "lab"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "serveraddress", "192.168.1.2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "servername", "tftp"),
+		),
+	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_boot" "testing" {
+	dhcp_option = {
+		"option:root-path" = "192.168.1.2:/srv/nfs"
+	}
+	filename = "lpxelinux.0"
+	force = true
+	id = "renamed"
+	networkid = "lab"
+	serveraddress = "192.168.1.2"
+	servername = "tftp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "filename", "lpxelinux.0"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_boot.testing", "id", "renamed"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		renameAndReadResource,
+	)
+}
+
+func TestResourceServerAddressWithoutServerNameAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_boot" "testing" {
+	filename = "pxelinux.0"
+	id = "testing"
+	serveraddress = "192.168.1.2"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,101 @@
+package cname
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	cnameAttribute            = "cname"
+	cnameAttributeDescription = "The alias. E.g. `www.example.com`."
+	cnameUCIOption            = "cname"
+
+	schemaDescription = "Binds an alias (CNAME record) to another domain name."
+	schemaVersion     = 0
+
+	targetAttribute            = "target"
+	targetAttributeDescription = "The domain name the alias points to. dnsmasq must already know this name (e.g. from `/etc/hosts`, a DHCP lease, or an `openwrt_dhcp_domain`). It is not looked up upstream."
+	targetUCIOption            = "target"
+
+	uciConfig = "dhcp"
+	uciType   = "cname"
+)
+
+var (
+	cnameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       cnameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetCNAME, cnameAttribute, cnameUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetCNAME, cnameAttribute, cnameUCIOption),
+		Validators:        domainNameValidators,
+	}
+
+	domainNameValidators = []validator.String{
+		stringvalidator.RegexMatches(
+			regexp.MustCompile("^[^[:space:],/]+$"),
+			"must not contain whitespace, commas, or slashes",
+		),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		cnameAttribute:                    cnameSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		targetAttribute:                   targetSchemaAttribute,
+	}
+
+	targetSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       targetAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTarget, targetAttribute, targetUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTarget, targetAttribute, targetUCIOption),
+		Validators:        domainNameValidators,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	CNAME        types.String `tfsdk:"cname"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Target       types.String `tfsdk:"target"`
+}
+
+func modelGetCNAME(m model) types.String     { return m.CNAME }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetTarget(m model) types.String    { return m.Target }
+
+func modelSetCNAME(m *model, value types.String)     { m.CNAME = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetTarget(m *model, value types.String)    { m.Target = value }
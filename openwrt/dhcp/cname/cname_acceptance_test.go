@@ -0,0 +1,166 @@
+//go:build acceptance.test
+
+package cname_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"cname":  lucirpc.String("www.example.com"),
+		"target": lucirpc.String("web.example.com"),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "cname", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_cname" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_cname.testing", "cname", "www.example.com"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_cname.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_cname.testing", "target", "web.example.com"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_cname" "testing" {
+	cname = "www.example.com"
+	id = "testing"
+	target = "web.example.com"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "cname", "www.example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "target", "web.example.com"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_cname.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_cname" "testing" {
+	cname = "www.example.net"
+	id = "testing"
+	target = "web.example.net"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "cname", "www.example.net"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "target", "web.example.net"),
+		),
+	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_cname" "testing" {
+	cname = "www.example.net"
+	id = "renamed"
+	target = "web.example.net"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "cname", "www.example.net"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "id", "renamed"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_cname.testing", "target", "web.example.net"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		renameAndReadResource,
+	)
+}
+
+func TestResourceInvalidTargetAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_cname" "testing" {
+	cname = "www.example.com"
+	id = "testing"
+	target = "web.example.com,other.example.com"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
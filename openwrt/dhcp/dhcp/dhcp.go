@@ -1,18 +1,33 @@
 package dhcp
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/internal/dhcpoption"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
 )
 
 const (
+	dhcpOptionAttribute            = "dhcp_option"
+	dhcpOptionAttributeDescription = "DHCP options to send to clients of this pool. " + dhcpoption.Description
+	dhcpOptionUCIOption            = "dhcp_option"
+
+	dhcpOptionForceAttribute            = "dhcp_option_force"
+	dhcpOptionForceAttributeDescription = "DHCP options to send to clients of this pool, even if the client does not request them. " + dhcpoption.Description
+	dhcpOptionForceUCIOption            = "dhcp_option_force"
+
 	dhcpv4ModeAttribute            = "dhcpv4"
 	dhcpv4ModeAttributeDescription = `The mode of the DHCPv4 server. Must be one of: "disabled", "server".`
 	dhcpv4ModeDisabled             = "disabled"
@@ -26,12 +41,23 @@ const (
 	dhcpv6ModeServer               = "server"
 	dhcpv6ModeUCIOption            = "dhcpv6"
 
+	dnsAttribute            = "dns"
+	dnsAttributeDescription = "DNS servers to announce to clients over DHCPv6 and Router Advertisements. If unset, the router announces itself."
+	dnsUCIOption            = "dns"
+
+	dynamicDHCPAttribute            = "dynamicdhcp"
+	dynamicDHCPAttributeDescription = "Dynamically allocate addresses to clients. If `false`, only clients with a static lease (e.g. an `openwrt_dhcp_host`) are served. Defaults to `true`."
+	dynamicDHCPDefaultValue         = true
+	dynamicDHCPUCIOption            = "dynamicdhcp"
+
 	forceAttribute            = "force"
-	forceAttributeDescription = "Forces DHCP serving on the specified interface even if another DHCP server is detected on the same network segment."
+	forceAttributeDescription = "Forces DHCP serving on the specified interface even if another DHCP server is detected on the same network segment. Defaults to `false`."
+	forceDefaultValue         = false
 	forceUCIOption            = "force"
 
 	ignoreAttribute            = "ignore"
-	ignoreAttributeDescription = "Specifies whether dnsmasq should ignore this pool."
+	ignoreAttributeDescription = "Specifies whether dnsmasq should ignore this pool. Defaults to `false`."
+	ignoreDefaultValue         = false
 	ignoreUCIOption            = "ignore"
 
 	interfaceAttribute            = "interface"
@@ -39,13 +65,24 @@ const (
 	interfaceUCIOption            = "interface"
 
 	leaseTimeAttribute            = "leasetime"
-	leaseTimeAttributeDescription = "The lease time of addresses handed out to clients. E.g. `12h`, or `30m`. Required if `ignore` is not `true`."
+	leaseTimeAttributeDescription = "The lease time of addresses handed out to clients. E.g. `12h`, or `30m`. Defaults to `12h`."
+	leaseTimeDefaultValue         = "12h"
 	leaseTimeUCIOption            = "leasetime"
 
 	limitAttribute            = "limit"
-	limitAttributeDescription = "Specifies the size of the address pool. E.g. With start = 100, and limit = 150, the maximum address will be 249. Required if `ignore` is not `true`."
+	limitAttributeDescription = "Specifies the size of the address pool. E.g. With start = 100, and limit = 150, the maximum address will be 249. Defaults to `150`."
+	limitDefaultValue         = 150
 	limitUCIOption            = "limit"
 
+	networkIdAttribute            = "networkid"
+	networkIdAttributeDescription = "The tag to set for clients of this pool. If unset, the name of the interface is used."
+	networkIdUCIOption            = "networkid"
+
+	routerAdvertisementDNSAttribute            = "ra_dns"
+	routerAdvertisementDNSAttributeDescription = "Announce DNS servers in Router Advertisements. Defaults to `true`."
+	routerAdvertisementDNSDefaultValue         = true
+	routerAdvertisementDNSUCIOption            = "ra_dns"
+
 	routerAdvertisementFlagsAttribute            = "ra_flags"
 	routerAdvertisementFlagsAttributeDescription = `Router Advertisement flags to include in messages. Must be one of: "home-agent", "managed-config", "none", "other-config".`
 	routerAdvertisementFlagsHomeAgent            = "home-agent"
@@ -62,16 +99,38 @@ const (
 	routerAdvertisementModeUCIOption            = "ra"
 
 	schemaDescription = "Per interface lease pools and settings for serving DHCP requests."
+	schemaVersion     = 0
 
 	startAttribute            = "start"
-	startAttributeDescription = "Specifies the offset from the network address of the underlying interface to calculate the minimum address that may be leased to clients. It may be greater than 255 to span subnets. Required if `ignore` is not `true`."
+	startAttributeDescription = "Specifies the offset from the network address of the underlying interface to calculate the minimum address that may be leased to clients. It may be greater than 255 to span subnets. Defaults to `100`."
+	startDefaultValue         = 100
 	startUCIOption            = "start"
 
+	tagsAttribute            = "tag"
+	tagsAttributeDescription = "Only serve clients with all of these tags. Prefix a tag with `!` to only serve clients without it."
+	tagsUCIOption            = "tag"
+
 	uciConfig = "dhcp"
 	uciType   = "dhcp"
 )
 
 var (
+	dhcpOptionSchemaAttribute = lucirpcglue.MapStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dhcpOptionAttributeDescription,
+		ReadResponse:      dhcpoption.ReadResponse(modelSetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     dhcpoption.UpsertRequest(modelGetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		Validators:        dhcpoption.Validators(),
+	}
+
+	dhcpOptionForceSchemaAttribute = lucirpcglue.MapStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dhcpOptionForceAttributeDescription,
+		ReadResponse:      dhcpoption.ReadResponse(modelSetDHCPOptionForce, dhcpOptionForceAttribute, dhcpOptionForceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     dhcpoption.UpsertRequest(modelGetDHCPOptionForce, dhcpOptionForceAttribute, dhcpOptionForceUCIOption),
+		Validators:        dhcpoption.Validators(),
+	}
+
 	dhcpv4ModeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       dhcpv4ModeAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDHCPv4Mode, dhcpv4ModeAttribute, dhcpv4ModeUCIOption),
@@ -99,7 +158,37 @@ var (
 		},
 	}
 
+	dnsSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dnsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetDNS, dnsAttribute, dnsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetDNS, dnsAttribute, dnsUCIOption),
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(
+				stringvalidator.Any(
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}$`),
+						`must be a valid IPv4 address (e.g. "192.168.3.1")`,
+					),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^[[:xdigit:]:]*:[[:xdigit:]:]*$"),
+						`must be a valid IPv6 address (e.g. "fd12:3456:789a::1")`,
+					),
+				),
+			),
+		},
+	}
+
+	dynamicDHCPSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(dynamicDHCPDefaultValue),
+		Description:       dynamicDHCPAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetDynamicDHCP, dynamicDHCPAttribute, dynamicDHCPUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetDynamicDHCP, dynamicDHCPAttribute, dynamicDHCPUCIOption),
+	}
+
 	forceSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(forceDefaultValue),
 		Description:       forceAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetForce, forceAttribute, forceUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -107,6 +196,7 @@ var (
 	}
 
 	ignoreSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(ignoreDefaultValue),
 		Description:       ignoreAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetIgnore, ignoreAttribute, ignoreUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -124,25 +214,42 @@ var (
 	}
 
 	leaseTimeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(leaseTimeDefaultValue),
 		Description:       leaseTimeAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLeaseTime, leaseTimeAttribute, leaseTimeUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetLeaseTime, leaseTimeAttribute, leaseTimeUCIOption),
-		Validators: []validator.String{
-			lucirpcglue.RequiredIfAttributeNotEqualBool(path.MatchRoot(ignoreAttribute), true),
-		},
 	}
 
 	limitSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(limitDefaultValue),
 		Description:       limitAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetLimit, limitAttribute, limitUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetLimit, limitAttribute, limitUCIOption),
-		Validators: []validator.Int64{
-			lucirpcglue.RequiredIfAttributeNotEqualBool(path.MatchRoot(ignoreAttribute), true),
+	}
+
+	networkIdSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       networkIdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetNetworkId, networkIdAttribute, networkIdUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetNetworkId, networkIdAttribute, networkIdUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[^[:space:],]+$"),
+				"must not contain whitespace or commas",
+			),
 		},
 	}
 
+	routerAdvertisementDNSSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(routerAdvertisementDNSDefaultValue),
+		Description:       routerAdvertisementDNSAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetRouterAdvertisementDNS, routerAdvertisementDNSAttribute, routerAdvertisementDNSUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetRouterAdvertisementDNS, routerAdvertisementDNSAttribute, routerAdvertisementDNSUCIOption),
+	}
+
 	routerAdvertisementFlagsSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       routerAdvertisementFlagsAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetRouterAdvertisementFlags, routerAdvertisementFlagsAttribute, routerAdvertisementFlagsUCIOption),
@@ -175,26 +282,47 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		dhcpOptionAttribute:               dhcpOptionSchemaAttribute,
+		dhcpOptionForceAttribute:          dhcpOptionForceSchemaAttribute,
 		dhcpv4ModeAttribute:               dhcpv4ModeSchemaAttribute,
 		dhcpv6ModeAttribute:               dhcpv6ModeSchemaAttribute,
+		dnsAttribute:                      dnsSchemaAttribute,
+		dynamicDHCPAttribute:              dynamicDHCPSchemaAttribute,
 		forceAttribute:                    forceSchemaAttribute,
 		ignoreAttribute:                   ignoreSchemaAttribute,
 		interfaceAttribute:                interfaceSchemaAttribute,
 		leaseTimeAttribute:                leaseTimeSchemaAttribute,
 		limitAttribute:                    limitSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
 		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		networkIdAttribute:                networkIdSchemaAttribute,
+		routerAdvertisementDNSAttribute:   routerAdvertisementDNSSchemaAttribute,
 		routerAdvertisementFlagsAttribute: routerAdvertisementFlagsSchemaAttribute,
 		routerAdvertisementModeAttribute:  routerAdvertisementModeSchemaAttribute,
 		startAttribute:                    startSchemaAttribute,
+		tagsAttribute:                     tagsSchemaAttribute,
 	}
 
 	startSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(startDefaultValue),
 		Description:       startAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetStart, startAttribute, startUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetStart, startAttribute, startUCIOption),
-		Validators: []validator.Int64{
-			lucirpcglue.RequiredIfAttributeNotEqualBool(path.MatchRoot(ignoreAttribute), true),
+	}
+
+	tagsSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tagsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetTags, tagsAttribute, tagsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetTags, tagsAttribute, tagsUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[^[:space:],]+$"),
+					"must not contain whitespace or commas",
+				),
+			),
 		},
 	}
 )
@@ -211,48 +339,75 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
+	DHCPOption               types.Map    `tfsdk:"dhcp_option"`
+	DHCPOptionForce          types.Map    `tfsdk:"dhcp_option_force"`
 	DHCPv4Mode               types.String `tfsdk:"dhcpv4"`
 	DHCPv6Mode               types.String `tfsdk:"dhcpv6"`
+	DNS                      types.List   `tfsdk:"dns"`
+	DynamicDHCP              types.Bool   `tfsdk:"dynamicdhcp"`
+	ExtraOptions             types.Map    `tfsdk:"extra_options"`
 	Force                    types.Bool   `tfsdk:"force"`
 	Id                       types.String `tfsdk:"id"`
 	Ignore                   types.Bool   `tfsdk:"ignore"`
 	Interface                types.String `tfsdk:"interface"`
 	LeaseTime                types.String `tfsdk:"leasetime"`
 	Limit                    types.Int64  `tfsdk:"limit"`
+	NetworkId                types.String `tfsdk:"networkid"`
+	RouterAdvertisementDNS   types.Bool   `tfsdk:"ra_dns"`
 	RouterAdvertisementFlags types.Set    `tfsdk:"ra_flags"`
 	RouterAdvertisementMode  types.String `tfsdk:"ra"`
 	Start                    types.Int64  `tfsdk:"start"`
+	Tags                     types.Set    `tfsdk:"tag"`
 }
 
+func modelGetDHCPOption(m model) types.Map                 { return m.DHCPOption }
+func modelGetDHCPOptionForce(m model) types.Map            { return m.DHCPOptionForce }
 func modelGetDHCPv4Mode(m model) types.String              { return m.DHCPv4Mode }
 func modelGetDHCPv6Mode(m model) types.String              { return m.DHCPv6Mode }
+func modelGetDNS(m model) types.List                       { return m.DNS }
+func modelGetDynamicDHCP(m model) types.Bool               { return m.DynamicDHCP }
+func modelGetExtraOptions(m model) types.Map               { return m.ExtraOptions }
 func modelGetForce(m model) types.Bool                     { return m.Force }
 func modelGetId(m model) types.String                      { return m.Id }
 func modelGetIgnore(m model) types.Bool                    { return m.Ignore }
 func modelGetInterface(m model) types.String               { return m.Interface }
 func modelGetLeaseTime(m model) types.String               { return m.LeaseTime }
 func modelGetLimit(m model) types.Int64                    { return m.Limit }
+func modelGetNetworkId(m model) types.String               { return m.NetworkId }
+func modelGetRouterAdvertisementDNS(m model) types.Bool    { return m.RouterAdvertisementDNS }
 func modelGetRouterAdvertisementFlags(m model) types.Set   { return m.RouterAdvertisementFlags }
 func modelGetRouterAdvertisementMode(m model) types.String { return m.RouterAdvertisementMode }
 func modelGetStart(m model) types.Int64                    { return m.Start }
+func modelGetTags(m model) types.Set                       { return m.Tags }
 
+func modelSetDHCPOption(m *model, value types.Map)                 { m.DHCPOption = value }
+func modelSetDHCPOptionForce(m *model, value types.Map)            { m.DHCPOptionForce = value }
 func modelSetDHCPv4Mode(m *model, value types.String)              { m.DHCPv4Mode = value }
 func modelSetDHCPv6Mode(m *model, value types.String)              { m.DHCPv6Mode = value }
+func modelSetDNS(m *model, value types.List)                       { m.DNS = value }
+func modelSetDynamicDHCP(m *model, value types.Bool)               { m.DynamicDHCP = value }
+func modelSetExtraOptions(m *model, value types.Map)               { m.ExtraOptions = value }
 func modelSetForce(m *model, value types.Bool)                     { m.Force = value }
 func modelSetId(m *model, value types.String)                      { m.Id = value }
 func modelSetIgnore(m *model, value types.Bool)                    { m.Ignore = value }
 func modelSetInterface(m *model, value types.String)               { m.Interface = value }
 func modelSetLeaseTime(m *model, value types.String)               { m.LeaseTime = value }
 func modelSetLimit(m *model, value types.Int64)                    { m.Limit = value }
+func modelSetNetworkId(m *model, value types.String)               { m.NetworkId = value }
+func modelSetRouterAdvertisementDNS(m *model, value types.Bool)    { m.RouterAdvertisementDNS = value }
 func modelSetRouterAdvertisementFlags(m *model, value types.Set)   { m.RouterAdvertisementFlags = value }
 func modelSetRouterAdvertisementMode(m *model, value types.String) { m.RouterAdvertisementMode = value }
 func modelSetStart(m *model, value types.Int64)                    { m.Start = value }
+func modelSetTags(m *model, value types.Set)                       { m.Tags = value }
@@ -5,6 +5,7 @@ package dhcp_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -26,9 +27,14 @@ func TestDataSourceAcceptance(t *testing.T) {
 	)
 	providerBlock := openWrtServer.ProviderBlock()
 	options := lucirpc.Options{
+		"dhcp_option": lucirpc.ListString([]string{
+			"3,192.168.1.1",
+			"option:ntp-server,192.168.1.2,192.168.1.3",
+		}),
 		"interface": lucirpc.String("testing"),
 		"leasetime": lucirpc.String("12h"),
 		"limit":     lucirpc.Integer(150),
+		"networkid": lucirpc.String("lan"),
 		"start":     lucirpc.Integer(100),
 	}
 	ok, err := client.CreateSection(ctx, "dhcp", "dhcp", "testing", options)
@@ -46,10 +52,14 @@ data "openwrt_dhcp_dhcp" "testing" {
 			providerBlock,
 		),
 		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "dhcp_option.%", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "dhcp_option.3", "192.168.1.1"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "dhcp_option.option:ntp-server", "192.168.1.2,192.168.1.3"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "id", "testing"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "interface", "testing"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "leasetime", "12h"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "limit", "150"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "networkid", "lan"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_dhcp.testing", "start", "100"),
 		),
 	}
@@ -83,13 +93,21 @@ resource "openwrt_dhcp_dhcp" "testing" {
 		Check: resource.ComposeAggregateTestCheckFunc(
 			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "id", "testing"),
 			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "ignore", "true"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option_force"),
 			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "dhcpv4"),
 			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "dhcpv6"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "dns"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dynamicdhcp", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "force", "false"),
 			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "interface"),
-			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "leasetime"),
-			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "limit"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "leasetime", "12h"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "limit", "150"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "networkid"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "ra_dns", "true"),
 			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "ra_flags"),
-			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "start"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "start", "100"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dhcp.testing", "tag"),
 		),
 	}
 	importValidation := resource.TestStep{
@@ -172,3 +190,146 @@ resource "openwrt_dhcp_dhcp" "testing" {
 		updateAndReadResource,
 	)
 }
+
+func TestResourceDHCPOptionsAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dhcp" "testing" {
+	dhcp_option = {
+		"6" = "192.168.1.53"
+		"option:ntp-server" = "192.168.1.2,192.168.1.3"
+		"tag:voip,43" = "01:04:c0:a8:01:0a"
+	}
+	dhcp_option_force = {
+		"option:domain-search" = "example.com"
+	}
+	dns = [
+		"fd12:3456:789a::53",
+	]
+	dynamicdhcp = false
+	id = "testing"
+	interface = "testing"
+	networkid = "testing"
+	ra_dns = false
+	tag = [
+		"!known",
+	]
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option.%", "3"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option.6", "192.168.1.53"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option.option:ntp-server", "192.168.1.2,192.168.1.3"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option.tag:voip,43", "01:04:c0:a8:01:0a"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option_force.%", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option_force.option:domain-search", "example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dns.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dns.0", "fd12:3456:789a::53"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dynamicdhcp", "false"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "networkid", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "ra_dns", "false"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "tag.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "tag.0", "!known"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_dhcp.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dhcp" "testing" {
+	dhcp_option = {
+		"option:ntp-server" = "192.168.1.2"
+	}
+	dhcp_option_force = {
+		"option:domain-search" = "example.com,example.net"
+	}
+	dns = [
+		"fd12:3456:789a::53",
+		"192.168.1.53",
+	]
+	dynamicdhcp = true
+	id = "testing"
+	interface = "testing"
+	networkid = "other"
+	ra_dns = true
+	tag = [
+		"!known",
+		"guest",
+	]
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option.%", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option.option:ntp-server", "192.168.1.2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option_force.%", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dhcp_option_force.option:domain-search", "example.com,example.net"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dns.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dns.0", "fd12:3456:789a::53"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dns.1", "192.168.1.53"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "dynamicdhcp", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "networkid", "other"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "ra_dns", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dhcp.testing", "tag.#", "2"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceInvalidDHCPOptionAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dhcp" "testing" {
+	dhcp_option = {
+		"3,192.168.1.1" = ""
+	}
+	id = "testing"
+	interface = "testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -1,40 +1,76 @@
 package dnsmasq
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
 )
 
 const (
+	addressAttribute            = "address"
+	addressAttributeDescription = "Answer queries for these domains (and their subdomains) with a fixed address, as `/domain/address`. E.g. `/example.com/192.168.1.2`, or `/ads.example.com/` to answer with NXDOMAIN."
+	addressUCIOption            = "address"
+
 	authoritativeModeAttribute            = "authoritative"
 	authoritativeModeAttributeDescription = "Force dnsmasq into authoritative mode. This speeds up DHCP leasing. Used if this is the only server on the network."
 	authoritativeModeUCIOption            = "authoritative"
 
+	cacheSizeAttribute            = "cachesize"
+	cacheSizeAttributeDescription = "The number of DNS answers to cache. Set to `0` to disable caching. Defaults to `150`."
+	cacheSizeDefaultValue         = 150
+	cacheSizeUCIOption            = "cachesize"
+
+	dhcpBootAttribute            = "dhcp_boot"
+	dhcpBootAttributeDescription = "Network boot (PXE) settings for every DHCP client, as `filename[,servername[,serveraddress]]`. E.g. `pxelinux.0,,192.168.1.2`. Use `openwrt_dhcp_boot` for settings that only apply to some clients."
+	dhcpBootUCIOption            = "dhcp_boot"
+
 	domainAttribute            = "domain"
 	domainAttributeDescription = "DNS domain handed out to DHCP clients."
 	domainUCIOption            = "domain"
 
 	domainNeededAttribute            = "domainneeded"
-	domainNeededAttributeDescription = "Never forward queries for plain names, without dots or domain parts, to upstream nameservers."
+	domainNeededAttributeDescription = "Never forward queries for plain names, without dots or domain parts, to upstream nameservers. Defaults to `false`."
+	domainNeededDefaultValue         = false
 	domainNeededUCIOption            = "domainneeded"
 
 	ednsPacketMaxAttribute            = "ednspacket_max"
-	ednsPacketMaxAttributeDescription = "Specify the largest EDNS.0 UDP packet which is supported by the DNS forwarder."
+	ednsPacketMaxAttributeDescription = "Specify the largest EDNS.0 UDP packet which is supported by the DNS forwarder. Defaults to `1280`."
+	ednsPacketMaxDefaultValue         = 1280
 	ednsPacketMaxUCIOption            = "ednspacket_max"
 
+	enableTFTPAttribute            = "enable_tftp"
+	enableTFTPAttributeDescription = "Enable the built-in TFTP server. Defaults to `false`."
+	enableTFTPDefaultValue         = false
+	enableTFTPUCIOption            = "enable_tftp"
+
 	expandHostsAttribute            = "expandhosts"
-	expandHostsAttributeDescription = "Never forward queries for plain names, without dots or domain parts, to upstream nameservers."
+	expandHostsAttributeDescription = "Never forward queries for plain names, without dots or domain parts, to upstream nameservers. Defaults to `false`."
+	expandHostsDefaultValue         = false
 	expandHostsUCIOption            = "expandhosts"
 
+	ipSetAttribute            = "ipset"
+	ipSetAttributeDescription = "Add the addresses of these domains to ipsets, as `/domain/ipset[,ipset]`. E.g. `/example.com/vpn`."
+	ipSetUCIOption            = "ipset"
+
 	leaseFileAttribute            = "leasefile"
 	leaseFileAttributeDescription = "Store DHCP leases in this file."
 	leaseFileUCIOption            = "leasefile"
 
 	localizeQueriesAttribute            = "localise_queries"
-	localizeQueriesAttributeDescription = "Choose IP address to match the incoming interface if multiple addresses are assigned to a host name in `/etc/hosts`."
+	localizeQueriesAttributeDescription = "Choose IP address to match the incoming interface if multiple addresses are assigned to a host name in `/etc/hosts`. Defaults to `false`."
+	localizeQueriesDefaultValue         = false
 	localizeQueriesUCIOption            = "localise_queries"
 
 	localLookupAttribute            = "local"
@@ -42,19 +78,41 @@ const (
 	localLookupUCIOption            = "local"
 
 	localServiceAttribute            = "localservice"
-	localServiceAttributeDescription = "Accept DNS queries only from hosts whose address is on a local subnet."
+	localServiceAttributeDescription = "Accept DNS queries only from hosts whose address is on a local subnet. Defaults to `true`."
+	localServiceDefaultValue         = true
 	localServiceUCIOption            = "localservice"
 
+	nftSetAttribute            = "nftset"
+	nftSetAttributeDescription = "Add the addresses of these domains to nftables sets, as `/domain/[4|6]#family#table#set`. E.g. `/example.com/4#inet#fw4#vpn`."
+	nftSetUCIOption            = "nftset"
+
+	noResolvAttribute            = "noresolv"
+	noResolvAttributeDescription = "Do not read upstream servers from the resolv file. Only the servers in `server` are used. Defaults to `false`."
+	noResolvDefaultValue         = false
+	noResolvUCIOption            = "noresolv"
+
+	portAttribute            = "port"
+	portAttributeDescription = "The port to listen for DNS queries on. Set to `0` to disable DNS. Defaults to `53`."
+	portDefaultValue         = 53
+	portUCIOption            = "port"
+
 	readEthersAttribute            = "readethers"
-	readEthersAttributeDescription = "Read static lease entries from `/etc/ethers`, re-read on SIGHUP."
+	readEthersAttributeDescription = "Read static lease entries from `/etc/ethers`, re-read on SIGHUP. Defaults to `false`."
+	readEthersDefaultValue         = false
 	readEthersUCIOption            = "readethers"
 
+	rebindDomainAttribute            = "rebind_domain"
+	rebindDomainAttributeDescription = "Domains allowed to resolve to private addresses even if rebind protection is enabled. E.g. `example.com`."
+	rebindDomainUCIOption            = "rebind_domain"
+
 	rebindLocalhostAttribute            = "rebind_localhost"
-	rebindLocalhostAttributeDescription = "Allows upstream 127.0.0.0/8 responses, required for DNS based blocklist services. Only takes effect if rebind protection is enabled."
+	rebindLocalhostAttributeDescription = "Allows upstream 127.0.0.0/8 responses, required for DNS based blocklist services. Only takes effect if rebind protection is enabled. Defaults to `false`."
+	rebindLocalhostDefaultValue         = false
 	rebindLocalhostUCIOption            = "rebind_localhost"
 
 	rebindProtectionAttribute            = "rebind_protection"
-	rebindProtectionAttributeDescription = "Enables DNS rebind attack protection by discarding upstream RFC1918 responses."
+	rebindProtectionAttributeDescription = "Enables DNS rebind attack protection by discarding upstream RFC1918 responses. Defaults to `true`."
+	rebindProtectionDefaultValue         = true
 	rebindProtectionUCIOption            = "rebind_protection"
 
 	resolvFileAttribute            = "resolvfile"
@@ -62,12 +120,36 @@ const (
 	resolvFileUCIOption            = "resolvfile"
 
 	schemaDescription = "A lightweight DHCP and caching DNS server."
+	schemaVersion     = 0
+
+	serverAttribute            = "server"
+	serverAttributeDescription = "Upstream DNS servers, in order. Prefix a server with `/domain/` to only use it for that domain. E.g. `1.1.1.1`, `9.9.9.9#53`, or `/example.com/192.168.1.2`."
+	serverUCIOption            = "server"
+
+	tftpRootAttribute            = "tftp_root"
+	tftpRootAttributeDescription = "The directory the built-in TFTP server serves files from. E.g. `/srv/tftp`. Requires `enable_tftp` to be `true`."
+	tftpRootUCIOption            = "tftp_root"
 
 	uciConfig = "dhcp"
 	uciType   = "dnsmasq"
 )
 
 var (
+	addressSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       addressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetAddress, addressAttribute, addressUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetAddress, addressAttribute, addressUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					domainPattern,
+					`must be domains and an address (e.g. "/example.com/192.168.1.2")`,
+				),
+			),
+		},
+	}
+
 	authoritativeModeSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       authoritativeModeAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetAuthoritativeMode, authoritativeModeAttribute, authoritativeModeUCIOption),
@@ -75,6 +157,31 @@ var (
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetAuthoritativeMode, authoritativeModeAttribute, authoritativeModeUCIOption),
 	}
 
+	cacheSizeSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(cacheSizeDefaultValue),
+		Description:       cacheSizeAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetCacheSize, cacheSizeAttribute, cacheSizeUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetCacheSize, cacheSizeAttribute, cacheSizeUCIOption),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	dhcpBootSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dhcpBootAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDHCPBoot, dhcpBootAttribute, dhcpBootUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDHCPBoot, dhcpBootAttribute, dhcpBootUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	// domainPattern matches values that start with one or more domains between slashes.
+	// E.g. "/example.com/192.168.1.2", or "/example.com/example.net/vpn".
+	domainPattern = regexp.MustCompile("^(/[^/[:space:]]+)+/[^/[:space:]]*$")
+
 	domainSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       domainAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDomain, domainAttribute, domainUCIOption),
@@ -83,6 +190,7 @@ var (
 	}
 
 	domainNeededSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(domainNeededDefaultValue),
 		Description:       domainNeededAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetDomainNeeded, domainNeededAttribute, domainNeededUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -90,19 +198,44 @@ var (
 	}
 
 	ednsPacketMaxSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(ednsPacketMaxDefaultValue),
 		Description:       ednsPacketMaxAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetEDNSPacketMax, ednsPacketMaxAttribute, ednsPacketMaxUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetEDNSPacketMax, ednsPacketMaxAttribute, ednsPacketMaxUCIOption),
 	}
 
+	enableTFTPSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enableTFTPDefaultValue),
+		Description:       enableTFTPAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnableTFTP, enableTFTPAttribute, enableTFTPUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetEnableTFTP, enableTFTPAttribute, enableTFTPUCIOption),
+	}
+
 	expandHostsSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(expandHostsDefaultValue),
 		Description:       expandHostsAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetExpandHosts, expandHostsAttribute, expandHostsUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetExpandHosts, expandHostsAttribute, expandHostsUCIOption),
 	}
 
+	ipSetSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ipSetAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetIPSet, ipSetAttribute, ipSetUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetIPSet, ipSetAttribute, ipSetUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					domainPattern,
+					`must be domains and ipsets (e.g. "/example.com/vpn")`,
+				),
+			),
+		},
+	}
+
 	leaseFileSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       leaseFileAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLeaseFile, leaseFileAttribute, leaseFileUCIOption),
@@ -111,6 +244,7 @@ var (
 	}
 
 	localizeQueriesSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(localizeQueriesDefaultValue),
 		Description:       localizeQueriesAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetLocalizeQueries, localizeQueriesAttribute, localizeQueriesUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -125,20 +259,69 @@ var (
 	}
 
 	localServiceSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(localServiceDefaultValue),
 		Description:       localServiceAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetLocalService, localServiceAttribute, localServiceUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetLocalService, localServiceAttribute, localServiceUCIOption),
 	}
 
+	nftSetSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nftSetAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetNFTSet, nftSetAttribute, nftSetUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetNFTSet, nftSetAttribute, nftSetUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					domainPattern,
+					`must be domains and nftables sets (e.g. "/example.com/4#inet#fw4#vpn")`,
+				),
+			),
+		},
+	}
+
+	noResolvSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(noResolvDefaultValue),
+		Description:       noResolvAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetNoResolv, noResolvAttribute, noResolvUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetNoResolv, noResolvAttribute, noResolvUCIOption),
+	}
+
+	portSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(portDefaultValue),
+		Description:       portAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetPort, portAttribute, portUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetPort, portAttribute, portUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+		},
+	}
+
 	readEthersSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(readEthersDefaultValue),
 		Description:       readEthersAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetReadEthers, readEthersAttribute, readEthersUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetReadEthers, readEthersAttribute, readEthersUCIOption),
 	}
 
+	rebindDomainSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       rebindDomainAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetRebindDomain, rebindDomainAttribute, rebindDomainUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetRebindDomain, rebindDomainAttribute, rebindDomainUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.LengthAtLeast(1),
+			),
+		},
+	}
+
 	rebindLocalhostSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(rebindLocalhostDefaultValue),
 		Description:       rebindLocalhostAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetRebindLocalhost, rebindLocalhostAttribute, rebindLocalhostUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -146,6 +329,7 @@ var (
 	}
 
 	rebindProtectionSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(rebindProtectionDefaultValue),
 		Description:       rebindProtectionAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetRebindProtection, rebindProtectionAttribute, rebindProtectionUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -160,20 +344,58 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		authoritativeModeAttribute: authoritativeModeSchemaAttribute,
-		domainAttribute:            domainSchemaAttribute,
-		domainNeededAttribute:      domainNeededSchemaAttribute,
-		ednsPacketMaxAttribute:     ednsPacketMaxSchemaAttribute,
-		expandHostsAttribute:       expandHostsSchemaAttribute,
-		leaseFileAttribute:         leaseFileSchemaAttribute,
-		localizeQueriesAttribute:   localizeQueriesSchemaAttribute,
-		localLookupAttribute:       localLookupSchemaAttribute,
-		localServiceAttribute:      localServiceSchemaAttribute,
-		lucirpcglue.IdAttribute:    lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		readEthersAttribute:        readEthersSchemaAttribute,
-		rebindLocalhostAttribute:   rebindLocalhostSchemaAttribute,
-		rebindProtectionAttribute:  rebindProtectionSchemaAttribute,
-		resolvFileAttribute:        resolvFileSchemaAttribute,
+		addressAttribute:                  addressSchemaAttribute,
+		authoritativeModeAttribute:        authoritativeModeSchemaAttribute,
+		cacheSizeAttribute:                cacheSizeSchemaAttribute,
+		dhcpBootAttribute:                 dhcpBootSchemaAttribute,
+		domainAttribute:                   domainSchemaAttribute,
+		domainNeededAttribute:             domainNeededSchemaAttribute,
+		ednsPacketMaxAttribute:            ednsPacketMaxSchemaAttribute,
+		enableTFTPAttribute:               enableTFTPSchemaAttribute,
+		expandHostsAttribute:              expandHostsSchemaAttribute,
+		ipSetAttribute:                    ipSetSchemaAttribute,
+		leaseFileAttribute:                leaseFileSchemaAttribute,
+		localizeQueriesAttribute:          localizeQueriesSchemaAttribute,
+		localLookupAttribute:              localLookupSchemaAttribute,
+		localServiceAttribute:             localServiceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		nftSetAttribute:                   nftSetSchemaAttribute,
+		noResolvAttribute:                 noResolvSchemaAttribute,
+		portAttribute:                     portSchemaAttribute,
+		readEthersAttribute:               readEthersSchemaAttribute,
+		rebindDomainAttribute:             rebindDomainSchemaAttribute,
+		rebindLocalhostAttribute:          rebindLocalhostSchemaAttribute,
+		rebindProtectionAttribute:         rebindProtectionSchemaAttribute,
+		resolvFileAttribute:               resolvFileSchemaAttribute,
+		serverAttribute:                   serverSchemaAttribute,
+		tftpRootAttribute:                 tftpRootSchemaAttribute,
+	}
+
+	serverSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       serverAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetServer, serverAttribute, serverUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetServer, serverAttribute, serverUCIOption),
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(
+				stringvalidator.LengthAtLeast(1),
+			),
+		},
+	}
+
+	tftpRootSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tftpRootAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTFTPRoot, tftpRootAttribute, tftpRootUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTFTPRoot, tftpRootAttribute, tftpRootUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(enableTFTPAttribute),
+				true,
+			),
+		},
 	}
 )
 
@@ -189,57 +411,96 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
+	Address           types.Set    `tfsdk:"address"`
 	AuthoritativeMode types.Bool   `tfsdk:"authoritative"`
+	CacheSize         types.Int64  `tfsdk:"cachesize"`
+	DHCPBoot          types.String `tfsdk:"dhcp_boot"`
 	Domain            types.String `tfsdk:"domain"`
 	DomainNeeded      types.Bool   `tfsdk:"domainneeded"`
 	EDNSPacketMax     types.Int64  `tfsdk:"ednspacket_max"`
+	EnableTFTP        types.Bool   `tfsdk:"enable_tftp"`
 	ExpandHosts       types.Bool   `tfsdk:"expandhosts"`
+	ExtraOptions      types.Map    `tfsdk:"extra_options"`
 	Id                types.String `tfsdk:"id"`
+	IPSet             types.Set    `tfsdk:"ipset"`
 	LeaseFile         types.String `tfsdk:"leasefile"`
 	LocalizeQueries   types.Bool   `tfsdk:"localise_queries"`
 	LocalLookup       types.String `tfsdk:"local"`
 	LocalService      types.Bool   `tfsdk:"localservice"`
+	NFTSet            types.Set    `tfsdk:"nftset"`
+	NoResolv          types.Bool   `tfsdk:"noresolv"`
+	Port              types.Int64  `tfsdk:"port"`
 	ReadEthers        types.Bool   `tfsdk:"readethers"`
+	RebindDomain      types.Set    `tfsdk:"rebind_domain"`
 	RebindLocalhost   types.Bool   `tfsdk:"rebind_localhost"`
 	RebindProtection  types.Bool   `tfsdk:"rebind_protection"`
 	ResolvFile        types.String `tfsdk:"resolvfile"`
+	Server            types.List   `tfsdk:"server"`
+	TFTPRoot          types.String `tfsdk:"tftp_root"`
 }
 
+func modelGetAddress(m model) types.Set            { return m.Address }
 func modelGetAuthoritativeMode(m model) types.Bool { return m.AuthoritativeMode }
+func modelGetCacheSize(m model) types.Int64        { return m.CacheSize }
+func modelGetDHCPBoot(m model) types.String        { return m.DHCPBoot }
 func modelGetDomain(m model) types.String          { return m.Domain }
 func modelGetDomainNeeded(m model) types.Bool      { return m.DomainNeeded }
 func modelGetEDNSPacketMax(m model) types.Int64    { return m.EDNSPacketMax }
+func modelGetEnableTFTP(m model) types.Bool        { return m.EnableTFTP }
 func modelGetExpandHosts(m model) types.Bool       { return m.ExpandHosts }
+func modelGetExtraOptions(m model) types.Map       { return m.ExtraOptions }
 func modelGetId(m model) types.String              { return m.Id }
+func modelGetIPSet(m model) types.Set              { return m.IPSet }
 func modelGetLeaseFile(m model) types.String       { return m.LeaseFile }
 func modelGetLocalizeQueries(m model) types.Bool   { return m.LocalizeQueries }
 func modelGetLocalLookup(m model) types.String     { return m.LocalLookup }
 func modelGetLocalService(m model) types.Bool      { return m.LocalService }
+func modelGetNFTSet(m model) types.Set             { return m.NFTSet }
+func modelGetNoResolv(m model) types.Bool          { return m.NoResolv }
+func modelGetPort(m model) types.Int64             { return m.Port }
 func modelGetReadEthers(m model) types.Bool        { return m.ReadEthers }
+func modelGetRebindDomain(m model) types.Set       { return m.RebindDomain }
 func modelGetRebindLocalhost(m model) types.Bool   { return m.RebindLocalhost }
 func modelGetRebindProtection(m model) types.Bool  { return m.RebindProtection }
 func modelGetResolvFile(m model) types.String      { return m.ResolvFile }
+func modelGetServer(m model) types.List            { return m.Server }
+func modelGetTFTPRoot(m model) types.String        { return m.TFTPRoot }
 
+func modelSetAddress(m *model, value types.Set)            { m.Address = value }
 func modelSetAuthoritativeMode(m *model, value types.Bool) { m.AuthoritativeMode = value }
+func modelSetCacheSize(m *model, value types.Int64)        { m.CacheSize = value }
+func modelSetDHCPBoot(m *model, value types.String)        { m.DHCPBoot = value }
 func modelSetDomain(m *model, value types.String)          { m.Domain = value }
 func modelSetDomainNeeded(m *model, value types.Bool)      { m.DomainNeeded = value }
 func modelSetEDNSPacketMax(m *model, value types.Int64)    { m.EDNSPacketMax = value }
+func modelSetEnableTFTP(m *model, value types.Bool)        { m.EnableTFTP = value }
 func modelSetExpandHosts(m *model, value types.Bool)       { m.ExpandHosts = value }
+func modelSetExtraOptions(m *model, value types.Map)       { m.ExtraOptions = value }
 func modelSetId(m *model, value types.String)              { m.Id = value }
+func modelSetIPSet(m *model, value types.Set)              { m.IPSet = value }
 func modelSetLeaseFile(m *model, value types.String)       { m.LeaseFile = value }
 func modelSetLocalizeQueries(m *model, value types.Bool)   { m.LocalizeQueries = value }
 func modelSetLocalLookup(m *model, value types.String)     { m.LocalLookup = value }
 func modelSetLocalService(m *model, value types.Bool)      { m.LocalService = value }
+func modelSetNFTSet(m *model, value types.Set)             { m.NFTSet = value }
+func modelSetNoResolv(m *model, value types.Bool)          { m.NoResolv = value }
+func modelSetPort(m *model, value types.Int64)             { m.Port = value }
 func modelSetReadEthers(m *model, value types.Bool)        { m.ReadEthers = value }
+func modelSetRebindDomain(m *model, value types.Set)       { m.RebindDomain = value }
 func modelSetRebindLocalhost(m *model, value types.Bool)   { m.RebindLocalhost = value }
 func modelSetRebindProtection(m *model, value types.Bool)  { m.RebindProtection = value }
 func modelSetResolvFile(m *model, value types.String)      { m.ResolvFile = value }
+func modelSetServer(m *model, value types.List)            { m.Server = value }
+func modelSetTFTPRoot(m *model, value types.String)        { m.TFTPRoot = value }
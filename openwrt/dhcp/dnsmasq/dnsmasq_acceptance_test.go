@@ -5,6 +5,7 @@ package dnsmasq_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -120,3 +121,220 @@ resource "openwrt_dhcp_dnsmasq" "testing" {
 		updateAndReadResource,
 	)
 }
+
+func TestResourceTFTPAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dnsmasq" "testing" {
+	dhcp_boot = "pxelinux.0"
+	enable_tftp = true
+	id = "testing"
+	tftp_root = "/srv/tftp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "dhcp_boot", "pxelinux.0"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "enable_tftp", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "tftp_root", "/srv/tftp"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_dnsmasq.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dnsmasq" "testing" {
+	dhcp_boot = "pxelinux.0,tftp,192.168.1.2"
+	enable_tftp = true
+	id = "testing"
+	tftp_root = "/mnt/tftp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "dhcp_boot", "pxelinux.0,tftp,192.168.1.2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "enable_tftp", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "tftp_root", "/mnt/tftp"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceTFTPRootWithoutTFTPAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dnsmasq" "testing" {
+	id = "testing"
+	tftp_root = "/srv/tftp"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceDNSAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dnsmasq" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dnsmasq.testing", "address"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "cachesize", "150"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dnsmasq.testing", "ipset"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dnsmasq.testing", "nftset"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "noresolv", "false"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "port", "53"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dnsmasq.testing", "rebind_domain"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_dnsmasq.testing", "server"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_dnsmasq.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dnsmasq" "testing" {
+	address = [
+		"/ads.example.com/",
+		"/router.example.com/192.168.1.1",
+	]
+	cachesize = 1000
+	id = "testing"
+	ipset = [
+		"/example.com/vpn",
+	]
+	nftset = [
+		"/example.com/4#inet#fw4#vpn",
+	]
+	noresolv = true
+	port = 5353
+	rebind_domain = [
+		"example.com",
+	]
+	server = [
+		"/example.com/192.168.1.2",
+		"9.9.9.9",
+		"1.1.1.1#53",
+	]
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "address.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "cachesize", "1000"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "ipset.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "ipset.0", "/example.com/vpn"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "nftset.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "nftset.0", "/example.com/4#inet#fw4#vpn"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "noresolv", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "port", "5353"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "rebind_domain.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "rebind_domain.0", "example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "server.#", "3"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "server.0", "/example.com/192.168.1.2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "server.1", "9.9.9.9"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_dnsmasq.testing", "server.2", "1.1.1.1#53"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceInvalidAddressAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_dnsmasq" "testing" {
+	address = [
+		"example.com=192.168.1.2",
+	]
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -22,6 +22,7 @@ const (
 	ipAddressUCIOption            = "ip"
 
 	schemaDescription = "Binds a domain name to an IP address."
+	schemaVersion     = 0
 
 	uciConfig = "dhcp"
 	uciType   = "domain"
@@ -51,9 +52,10 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		hostnameAttribute:       hostnameSchemaAttribute,
-		ipAddressAttribute:      ipAddressSchemaAttribute,
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		hostnameAttribute:                 hostnameSchemaAttribute,
+		ipAddressAttribute:                ipAddressSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
 	}
 )
 
@@ -69,24 +71,30 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
-	Hostname  types.String `tfsdk:"name"`
-	Id        types.String `tfsdk:"id"`
-	IPAddress types.String `tfsdk:"ip"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Hostname     types.String `tfsdk:"name"`
+	Id           types.String `tfsdk:"id"`
+	IPAddress    types.String `tfsdk:"ip"`
 }
 
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
 func modelGetHostname(m model) types.String  { return m.Hostname }
 func modelGetId(m model) types.String        { return m.Id }
 func modelGetIPAddress(m model) types.String { return m.IPAddress }
 
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
 func modelSetHostname(m *model, value types.String)  { m.Hostname = value }
 func modelSetId(m *model, value types.String)        { m.Id = value }
 func modelSetIPAddress(m *model, value types.String) { m.IPAddress = value }
@@ -106,11 +106,30 @@ resource "openwrt_dhcp_domain" "testing" {
 			resource.TestCheckResourceAttr("openwrt_dhcp_domain.testing", "name", "testing-1"),
 		),
 	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_domain" "testing" {
+	id = "renamed"
+	ip = "192.168.1.51"
+	name = "testing-1"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_domain.testing", "id", "renamed"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_domain.testing", "ip", "192.168.1.51"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_domain.testing", "name", "testing-1"),
+		),
+	}
 
 	acceptancetest.TerraformSteps(
 		t,
 		createAndReadResource,
 		importValidation,
 		updateAndReadResource,
+		renameAndReadResource,
 	)
 }
@@ -1,35 +1,71 @@
 package host
 
 import (
+	"context"
 	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/logger"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
 )
 
 const (
 	addDNSEntriesAttribute            = "dns"
-	addDNSEntriesAttributeDescription = "Add static forward and reverse DNS entries for this host."
+	addDNSEntriesAttributeDescription = "Add static forward and reverse DNS entries for this host. Defaults to `false`."
+	addDNSEntriesDefaultValue         = false
 	addDNSEntriesUCIOption            = "dns"
 
+	broadcastAttribute            = "broadcast"
+	broadcastAttributeDescription = "Force replies to this host to be broadcast. Some clients need this to accept a lease. Defaults to `false`."
+	broadcastDefaultValue         = false
+	broadcastUCIOption            = "broadcast"
+
+	duidAttribute            = "duid"
+	duidAttributeDescription = "The DHCPv6 DUID of this host, as hexadecimal characters. It can be followed by `%` and the IAID to match a specific interface (e.g. \"00010001a1b2c3d4001122334455%1a2b3c4d\")."
+	duidUCIOption            = "duid"
+
+	hostIdAttribute            = "hostid"
+	hostIdAttributeDescription = "The IPv6 interface identifier (address suffix) to assign, as up to 8 hexadecimal characters (e.g. \"23\")."
+	hostIdUCIOption            = "hostid"
+
 	hostnameAttribute            = "name"
 	hostnameAttributeDescription = "Hostname to assign."
 	hostnameUCIOption            = "name"
 
+	instanceAttribute            = "instance"
+	instanceAttributeDescription = "The dnsmasq instance this host belongs to. This is the `id` of an `openwrt_dhcp_dnsmasq`. If unset, every instance uses this host."
+	instanceUCIOption            = "instance"
+
 	ipAddressAttribute            = "ip"
 	ipAddressAttributeDescription = "The IP address to be used for this host, or `ignore` to ignore any DHCP request from this host."
 	ipAddressUCIOption            = "ip"
 
+	leaseTimeAttribute            = "leasetime"
+	leaseTimeAttributeDescription = "The lease time of the address handed out to this host. E.g. `12h`, `30m`, or `infinite`. If unset, the lease time of the pool is used."
+	leaseTimeUCIOption            = "leasetime"
+
 	macAddressAttribute            = "mac"
-	macAddressAttributeDescription = "The hardware address(es) of this host, separated by spaces."
+	macAddressAttributeDescription = "The hardware addresses of this host. A host with several network cards (e.g. wired and wireless) gets the same lease on each."
 	macAddressUCIOption            = "mac"
 
 	schemaDescription = "Assign a fixed IP address to hosts."
+	schemaVersion     = 1
+
+	tagsAttribute            = "tag"
+	tagsAttributeDescription = "Tags to set for this host, so DHCP options for those tags apply to it."
+	tagsUCIOption            = "tag"
 
 	uciConfig = "dhcp"
 	uciType   = "host"
@@ -37,12 +73,47 @@ const (
 
 var (
 	addDNSEntriesSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(addDNSEntriesDefaultValue),
 		Description:       addDNSEntriesAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetAddDNSEntries, addDNSEntriesAttribute, addDNSEntriesUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetAddDNSEntries, addDNSEntriesAttribute, addDNSEntriesUCIOption),
 	}
 
+	broadcastSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(broadcastDefaultValue),
+		Description:       broadcastAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetBroadcast, broadcastAttribute, broadcastUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetBroadcast, broadcastAttribute, broadcastUCIOption),
+	}
+
+	duidSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       duidAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDUID, duidAttribute, duidUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDUID, duidAttribute, duidUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]){2,130}(%[[:xdigit:]]{1,8})?$"),
+				`must be a valid DUID, optionally followed by an IAID (e.g. "00010001a1b2c3d4001122334455%1a2b3c4d")`,
+			),
+		},
+	}
+
+	hostIdSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       hostIdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetHostId, hostIdAttribute, hostIdUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetHostId, hostIdAttribute, hostIdUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:xdigit:]]{1,8}$"),
+				`must be between 1 and 8 hexadecimal characters (e.g. "23")`,
+			),
+		},
+	}
+
 	hostnameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       hostnameAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetHostname, hostnameAttribute, hostnameUCIOption),
@@ -50,6 +121,16 @@ var (
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetHostname, hostnameAttribute, hostnameUCIOption),
 	}
 
+	instanceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       instanceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetInstance, instanceAttribute, instanceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetInstance, instanceAttribute, instanceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
 	ipAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       ipAddressAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIPAddress, ipAddressAttribute, ipAddressUCIOption),
@@ -61,32 +142,90 @@ var (
 					"ignore",
 				),
 				stringvalidator.RegexMatches(
-					regexp.MustCompile("^([[:digit:]]{1,3}.){3}[[:digit:]]{1,3}$"),
+					regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}$`),
 					`must be a valid IP address (e.g. "192.168.3.1")`,
 				),
 			),
 		},
 	}
 
-	macAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       macAddressAttributeDescription,
-		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMACAddress, macAddressAttribute, macAddressUCIOption),
+	leaseTimeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       leaseTimeAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLeaseTime, leaseTimeAttribute, leaseTimeUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
-		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetMACAddress, macAddressAttribute, macAddressUCIOption),
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetLeaseTime, leaseTimeAttribute, leaseTimeUCIOption),
 		Validators: []validator.String{
 			stringvalidator.RegexMatches(
-				regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]]$"),
-				`must be a valid MAC address (e.g. "12:34:56:78:90:ab")`,
+				regexp.MustCompile("^([[:digit:]]+[smhdw]?|infinite)$"),
+				`must be a duration (e.g. "12h") or "infinite"`,
+			),
+		},
+	}
+
+	macAddressSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       macAddressAttributeDescription,
+		ReadResponse:      readResponseMACAddress,
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetMACAddress, macAddressAttribute, macAddressUCIOption),
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]]$"),
+					`must be a valid MAC address (e.g. "12:34:56:78:90:ab")`,
+				),
 			),
 		},
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		addDNSEntriesAttribute:  addDNSEntriesSchemaAttribute,
-		hostnameAttribute:       hostnameSchemaAttribute,
-		ipAddressAttribute:      ipAddressSchemaAttribute,
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		macAddressAttribute:     macAddressSchemaAttribute,
+		addDNSEntriesAttribute:            addDNSEntriesSchemaAttribute,
+		broadcastAttribute:                broadcastSchemaAttribute,
+		duidAttribute:                     duidSchemaAttribute,
+		hostIdAttribute:                   hostIdSchemaAttribute,
+		hostnameAttribute:                 hostnameSchemaAttribute,
+		instanceAttribute:                 instanceSchemaAttribute,
+		ipAddressAttribute:                ipAddressSchemaAttribute,
+		leaseTimeAttribute:                leaseTimeSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		macAddressAttribute:               macAddressSchemaAttribute,
+		tagsAttribute:                     tagsSchemaAttribute,
+	}
+
+	// schemaAttributesV0 is the schema before `mac` was a list.
+	// Only the types matter,
+	// since it is only used to read prior state.
+	schemaAttributesV0 = map[string]lucirpcglue.SchemaAttribute[modelV0, lucirpc.Options, lucirpc.Options]{
+		addDNSEntriesAttribute: lucirpcglue.BoolSchemaAttribute[modelV0, lucirpc.Options, lucirpc.Options]{
+			ResourceExistence: lucirpcglue.NoValidation,
+		},
+		hostnameAttribute: lucirpcglue.StringSchemaAttribute[modelV0, lucirpc.Options, lucirpc.Options]{
+			ResourceExistence: lucirpcglue.NoValidation,
+		},
+		ipAddressAttribute: lucirpcglue.StringSchemaAttribute[modelV0, lucirpc.Options, lucirpc.Options]{
+			ResourceExistence: lucirpcglue.NoValidation,
+		},
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelV0GetExtraOptions, modelV0SetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelV0GetId, modelV0SetId),
+		macAddressAttribute: lucirpcglue.StringSchemaAttribute[modelV0, lucirpc.Options, lucirpc.Options]{
+			ResourceExistence: lucirpcglue.NoValidation,
+		},
+	}
+
+	tagsSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tagsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetTags, tagsAttribute, tagsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetTags, tagsAttribute, tagsUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[^[:space:],]+$"),
+					"must not contain whitespace or commas",
+				),
+			),
+		},
 	}
 )
 
@@ -102,9 +241,14 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		map[int64]resource.StateUpgrader{
+			0: lucirpcglue.StateUpgrader(schemaAttributesV0, upgradeStateV0),
+		},
 		uciConfig,
 		uciType,
 	)
@@ -112,20 +256,129 @@ func NewResource() resource.Resource {
 
 type model struct {
 	AddDNSEntries types.Bool   `tfsdk:"dns"`
+	Broadcast     types.Bool   `tfsdk:"broadcast"`
+	DUID          types.String `tfsdk:"duid"`
+	ExtraOptions  types.Map    `tfsdk:"extra_options"`
+	HostId        types.String `tfsdk:"hostid"`
 	Hostname      types.String `tfsdk:"name"`
 	Id            types.String `tfsdk:"id"`
+	Instance      types.String `tfsdk:"instance"`
 	IPAddress     types.String `tfsdk:"ip"`
-	MACAddress    types.String `tfsdk:"mac"`
+	LeaseTime     types.String `tfsdk:"leasetime"`
+	MACAddress    types.List   `tfsdk:"mac"`
+	Tags          types.Set    `tfsdk:"tag"`
 }
 
 func modelGetAddDNSEntries(m model) types.Bool { return m.AddDNSEntries }
+func modelGetBroadcast(m model) types.Bool     { return m.Broadcast }
+func modelGetDUID(m model) types.String        { return m.DUID }
+func modelGetExtraOptions(m model) types.Map   { return m.ExtraOptions }
+func modelGetHostId(m model) types.String      { return m.HostId }
 func modelGetHostname(m model) types.String    { return m.Hostname }
 func modelGetId(m model) types.String          { return m.Id }
+func modelGetInstance(m model) types.String    { return m.Instance }
 func modelGetIPAddress(m model) types.String   { return m.IPAddress }
-func modelGetMACAddress(m model) types.String  { return m.MACAddress }
+func modelGetLeaseTime(m model) types.String   { return m.LeaseTime }
+func modelGetMACAddress(m model) types.List    { return m.MACAddress }
+func modelGetTags(m model) types.Set           { return m.Tags }
 
 func modelSetAddDNSEntries(m *model, value types.Bool) { m.AddDNSEntries = value }
+func modelSetBroadcast(m *model, value types.Bool)     { m.Broadcast = value }
+func modelSetDUID(m *model, value types.String)        { m.DUID = value }
+func modelSetExtraOptions(m *model, value types.Map)   { m.ExtraOptions = value }
+func modelSetHostId(m *model, value types.String)      { m.HostId = value }
 func modelSetHostname(m *model, value types.String)    { m.Hostname = value }
 func modelSetId(m *model, value types.String)          { m.Id = value }
+func modelSetInstance(m *model, value types.String)    { m.Instance = value }
 func modelSetIPAddress(m *model, value types.String)   { m.IPAddress = value }
-func modelSetMACAddress(m *model, value types.String)  { m.MACAddress = value }
+func modelSetLeaseTime(m *model, value types.String)   { m.LeaseTime = value }
+func modelSetMACAddress(m *model, value types.List)    { m.MACAddress = value }
+func modelSetTags(m *model, value types.Set)           { m.Tags = value }
+
+type modelV0 struct {
+	AddDNSEntries types.Bool   `tfsdk:"dns"`
+	ExtraOptions  types.Map    `tfsdk:"extra_options"`
+	Hostname      types.String `tfsdk:"name"`
+	Id            types.String `tfsdk:"id"`
+	IPAddress     types.String `tfsdk:"ip"`
+	MACAddress    types.String `tfsdk:"mac"`
+}
+
+func modelV0GetExtraOptions(m modelV0) types.Map { return m.ExtraOptions }
+func modelV0GetId(m modelV0) types.String        { return m.Id }
+
+func modelV0SetExtraOptions(m *modelV0, value types.Map) { m.ExtraOptions = value }
+func modelV0SetId(m *modelV0, value types.String)        { m.Id = value }
+
+// readResponseMACAddress reads `mac` as either a list or an option.
+// dnsmasq accepts both,
+// and an option can hold several addresses separated by spaces.
+// Sections written before `mac` was a list use an option.
+func readResponseMACAddress(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	section lucirpc.Options,
+	m model,
+) (context.Context, model, diag.Diagnostics) {
+	_, err := section.GetString(macAddressUCIOption)
+	if err != nil {
+		return lucirpcglue.ReadResponseOptionListString(modelSetMACAddress, macAddressAttribute, macAddressUCIOption)(ctx, fullTypeName, terraformType, section, m)
+	}
+
+	ctx, macAddresses, diagnostics := lucirpcglue.GetOptionString(ctx, fullTypeName, terraformType, section, path.Root(macAddressAttribute), macAddressUCIOption)
+	if diagnostics.HasError() {
+		return ctx, m, diagnostics
+	}
+
+	value, diagnostics := splitMACAddresses(macAddresses)
+	if diagnostics.HasError() {
+		return ctx, m, diagnostics
+	}
+
+	ctx = logger.SetFieldListString(ctx, fullTypeName, terraformType, macAddressAttribute, value)
+	modelSetMACAddress(&m, value)
+	return ctx, m, diagnostics
+}
+
+func splitMACAddresses(
+	macAddresses types.String,
+) (types.List, diag.Diagnostics) {
+	if macAddresses.IsNull() || macAddresses.IsUnknown() {
+		return types.ListNull(types.StringType), diag.Diagnostics{}
+	}
+
+	values := []attr.Value{}
+	for _, macAddress := range strings.Fields(macAddresses.ValueString()) {
+		values = append(values, types.StringValue(macAddress))
+	}
+
+	return types.ListValue(types.StringType, values)
+}
+
+// upgradeStateV0 turns `mac` into a list.
+// The other new attributes are left unset for the next read to fill in.
+func upgradeStateV0(
+	ctx context.Context,
+	prior modelV0,
+) (model, diag.Diagnostics) {
+	macAddress, diagnostics := splitMACAddresses(prior.MACAddress)
+	if diagnostics.HasError() {
+		return model{}, diagnostics
+	}
+
+	return model{
+		AddDNSEntries: prior.AddDNSEntries,
+		Broadcast:     types.BoolNull(),
+		DUID:          types.StringNull(),
+		ExtraOptions:  prior.ExtraOptions,
+		HostId:        types.StringNull(),
+		Hostname:      prior.Hostname,
+		Id:            prior.Id,
+		Instance:      types.StringNull(),
+		IPAddress:     prior.IPAddress,
+		LeaseTime:     types.StringNull(),
+		MACAddress:    macAddress,
+		Tags:          types.SetNull(types.StringType),
+	}, diagnostics
+}
@@ -5,6 +5,7 @@ package host_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -27,7 +28,7 @@ func TestDataSourceAcceptance(t *testing.T) {
 	providerBlock := openWrtServer.ProviderBlock()
 	options := lucirpc.Options{
 		"ip":   lucirpc.String("192.168.1.50"),
-		"mac":  lucirpc.String("12:34:56:78:90:ab"),
+		"mac":  lucirpc.String("12:34:56:78:90:ab 12:34:56:78:90:cd"),
 		"name": lucirpc.String("testing"),
 	}
 	ok, err := client.CreateSection(ctx, "dhcp", "host", "testing", options)
@@ -47,7 +48,9 @@ data "openwrt_dhcp_host" "testing" {
 		Check: resource.ComposeAggregateTestCheckFunc(
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "id", "testing"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "ip", "192.168.1.50"),
-			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "mac", "12:34:56:78:90:ab"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "mac.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "mac.0", "12:34:56:78:90:ab"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "mac.1", "12:34:56:78:90:cd"),
 			resource.TestCheckResourceAttr("data.openwrt_dhcp_host.testing", "name", "testing"),
 		),
 	}
@@ -74,7 +77,9 @@ func TestResourceAcceptance(t *testing.T) {
 resource "openwrt_dhcp_host" "testing" {
 	id = "testing"
 	ip = "192.168.1.50"
-	mac = "12:34:56:78:90:ab"
+	mac = [
+		"12:34:56:78:90:ab",
+	]
 }
 `,
 			providerBlock,
@@ -82,7 +87,8 @@ resource "openwrt_dhcp_host" "testing" {
 		Check: resource.ComposeAggregateTestCheckFunc(
 			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "id", "testing"),
 			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "ip", "192.168.1.50"),
-			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac", "12:34:56:78:90:ab"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.0", "12:34:56:78:90:ab"),
 		),
 	}
 	importValidation := resource.TestStep{
@@ -97,7 +103,9 @@ resource "openwrt_dhcp_host" "testing" {
 resource "openwrt_dhcp_host" "testing" {
 	id = "testing"
 	ip = "192.168.1.50"
-	mac = "12:34:56:78:90:ab"
+	mac = [
+		"12:34:56:78:90:ab",
+	]
 	name = "testing"
 }
 `,
@@ -106,7 +114,8 @@ resource "openwrt_dhcp_host" "testing" {
 		Check: resource.ComposeAggregateTestCheckFunc(
 			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "id", "testing"),
 			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "ip", "192.168.1.50"),
-			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac", "12:34:56:78:90:ab"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.0", "12:34:56:78:90:ab"),
 			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "name", "testing"),
 		),
 	}
@@ -118,3 +127,132 @@ resource "openwrt_dhcp_host" "testing" {
 		updateAndReadResource,
 	)
 }
+
+func TestResourceIgnoreAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_host" "testing" {
+	id = "testing"
+	ip = "ignore"
+	mac = [
+		"12:34:56:78:90:ab",
+	]
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "ip", "ignore"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+	)
+}
+
+func TestResourceIPv6Acceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_host" "testing" {
+	broadcast = true
+	duid = "00010001a1b2c3d4001122334455"
+	hostid = "23"
+	id = "testing"
+	instance = "cfg01411c"
+	ip = "192.168.1.50"
+	leasetime = "infinite"
+	mac = [
+		"12:34:56:78:90:ab",
+		"12:34:56:78:90:cd",
+	]
+	name = "testing"
+	tag = [
+		"known",
+	]
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "broadcast", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "duid", "00010001a1b2c3d4001122334455"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "hostid", "23"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "instance", "cfg01411c"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "ip", "192.168.1.50"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "leasetime", "infinite"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.0", "12:34:56:78:90:ab"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "mac.1", "12:34:56:78:90:cd"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "name", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "tag.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_host.testing", "tag.0", "known"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_host.testing",
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+	)
+}
+
+func TestResourceInvalidHostIdAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_host" "testing" {
+	hostid = "::23"
+	id = "testing"
+	mac = [
+		"12:34:56:78:90:ab",
+	]
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,194 @@
+// Package dhcpoption converts between dnsmasq's DHCP options and a map in Terraform.
+//
+// dnsmasq takes DHCP options as a list of strings like "3,192.168.1.1" or "option:ntp-server,192.168.1.2".
+// In Terraform, they are a map from the option (e.g. "3", or "option:ntp-server") to its value (e.g. "192.168.1.1").
+// Any tags or encapsulation (e.g. "tag:voip,43") are part of the option,
+// so the map round-trips without diffs.
+package dhcpoption
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/logger"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	// Description explains the keys and values of the map.
+	// It is meant to be appended to the description of an attribute.
+	Description = "Keys are the option number or name (e.g. \"42\", or \"option:ntp-server\"), optionally prefixed with tags or encapsulation (e.g. \"tag:voip,43\"). Values are the comma-separated value of the option (e.g. \"192.168.1.2,192.168.1.3\"). An empty value sends the option with no value."
+)
+
+var (
+	keyPattern    = regexp.MustCompile("^((encap|net|tag|vendor|vi-encap):[^,]+,)*([[:digit:]]+|option6?:[[:alnum:]-]+)$")
+	optionPattern = regexp.MustCompile("^([[:digit:]]+|option6?:[[:alnum:]-]+)$")
+	prefixPattern = regexp.MustCompile("^(encap|net|tag|vendor|vi-encap):[^,]+$")
+)
+
+// ReadResponse reads the given list option into a map attribute.
+func ReadResponse[Model any](
+	set func(*Model, types.Map),
+	attribute string,
+	option string,
+) func(context.Context, string, string, lucirpc.Options, Model) (context.Context, Model, diag.Diagnostics) {
+	return func(
+		ctx context.Context,
+		fullTypeName string,
+		terraformType string,
+		section lucirpc.Options,
+		model Model,
+	) (context.Context, Model, diag.Diagnostics) {
+		attributePath := path.Root(attribute)
+		ctx, list, diagnostics := lucirpcglue.GetOptionListString(ctx, fullTypeName, terraformType, section, attributePath, option)
+		if diagnostics.HasError() {
+			return ctx, model, diagnostics
+		}
+
+		if list.IsNull() {
+			set(&model, types.MapNull(types.StringType))
+			return ctx, model, diagnostics
+		}
+
+		var entries []string
+		diagnostics.Append(list.ElementsAs(ctx, &entries, false)...)
+		if diagnostics.HasError() {
+			return ctx, model, diagnostics
+		}
+
+		values := map[string]attr.Value{}
+		for _, entry := range entries {
+			key, value, err := parse(entry)
+			if err != nil {
+				diagnostics.AddAttributeError(
+					attributePath,
+					fmt.Sprintf("unable to parse option: %q", option),
+					err.Error(),
+				)
+				continue
+			}
+
+			if _, ok := values[key]; ok {
+				diagnostics.AddAttributeError(
+					attributePath,
+					fmt.Sprintf("unable to parse option: %q", option),
+					fmt.Sprintf("DHCP option %q is set more than once", key),
+				)
+				continue
+			}
+
+			values[key] = types.StringValue(value)
+		}
+
+		if diagnostics.HasError() {
+			return ctx, model, diagnostics
+		}
+
+		result, diagnostics := types.MapValue(types.StringType, values)
+		if diagnostics.HasError() {
+			return ctx, model, diagnostics
+		}
+
+		ctx = logger.SetFieldMap(ctx, fullTypeName, terraformType, attribute, result)
+		set(&model, result)
+		return ctx, model, diagnostics
+	}
+}
+
+// UpsertRequest writes a map attribute to the given list option.
+// The list is sorted by option,
+// so the same map always writes the same list.
+func UpsertRequest[Model any](
+	get func(Model) types.Map,
+	attribute string,
+	option string,
+) func(context.Context, string, lucirpc.Options, Model) (context.Context, lucirpc.Options, diag.Diagnostics) {
+	return func(
+		ctx context.Context,
+		fullTypeName string,
+		options lucirpc.Options,
+		model Model,
+	) (context.Context, lucirpc.Options, diag.Diagnostics) {
+		value := get(model)
+		if value.IsNull() || value.IsUnknown() {
+			return ctx, options, diag.Diagnostics{}
+		}
+
+		var values map[string]string
+		diagnostics := value.ElementsAs(ctx, &values, false)
+		if diagnostics.HasError() {
+			return ctx, options, diagnostics
+		}
+
+		keys := []string{}
+		for key := range values {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+		entries := []string{}
+		for _, key := range keys {
+			entries = append(entries, serialize(key, values[key]))
+		}
+
+		ctx = logger.SetFieldMap(ctx, fullTypeName, lucirpcglue.ResourceTerraformType, attribute, value)
+		options[option] = lucirpc.ListString(entries)
+		return ctx, options, diagnostics
+	}
+}
+
+// Validators checks the keys of the map are DHCP options that dnsmasq understands.
+func Validators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.KeysAre(
+			stringvalidator.RegexMatches(
+				keyPattern,
+				`must be a DHCP option number or name (e.g. "42", or "option:ntp-server"), optionally prefixed with tags or encapsulation (e.g. "tag:voip,43")`,
+			),
+		),
+	}
+}
+
+// parse splits an entry into the option (including any prefixes) and its value.
+func parse(
+	entry string,
+) (string, string, error) {
+	parts := strings.Split(entry, ",")
+	for index, part := range parts {
+		if prefixPattern.MatchString(part) {
+			continue
+		}
+
+		if !optionPattern.MatchString(part) {
+			return "", "", fmt.Errorf("expected a DHCP option number or name, got: %q in %q", part, entry)
+		}
+
+		key := strings.Join(parts[:index+1], ",")
+		value := strings.Join(parts[index+1:], ",")
+		return key, value, nil
+	}
+
+	return "", "", fmt.Errorf("expected a DHCP option number or name in %q", entry)
+}
+
+func serialize(
+	key string,
+	value string,
+) string {
+	if value == "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s,%s", key, value)
+}
@@ -0,0 +1,139 @@
+package match
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/internal/dhcpoption"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	dhcpOptionAttribute            = "dhcp_option"
+	dhcpOptionAttributeDescription = "DHCP options to send to clients that match. " + dhcpoption.Description
+	dhcpOptionUCIOption            = "dhcp_option"
+
+	forceAttribute            = "force"
+	forceAttributeDescription = "Send the DHCP options even if the client does not request them. Defaults to `false`."
+	forceDefaultValue         = false
+	forceUCIOption            = "force"
+
+	matchAttribute            = "match"
+	matchAttributeDescription = "The DHCP option the client sends, and optionally the value it must have. E.g. `77,iPXE` to match a user class, `option:client-arch,6` to match UEFI clients, or `175` to match any client sending option 175."
+	matchUCIOption            = "match"
+
+	networkIdAttribute            = "networkid"
+	networkIdAttributeDescription = "The tag to set for clients that match."
+	networkIdUCIOption            = "networkid"
+
+	schemaDescription = "Sets a tag for clients that send a DHCP option, so DHCP options for that tag apply to them."
+	schemaVersion     = 0
+
+	uciConfig = "dhcp"
+	uciType   = "match"
+)
+
+var (
+	dhcpOptionSchemaAttribute = lucirpcglue.MapStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dhcpOptionAttributeDescription,
+		ReadResponse:      dhcpoption.ReadResponse(modelSetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     dhcpoption.UpsertRequest(modelGetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		Validators:        dhcpoption.Validators(),
+	}
+
+	forceSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(forceDefaultValue),
+		Description:       forceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetForce, forceAttribute, forceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetForce, forceAttribute, forceUCIOption),
+	}
+
+	matchSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       matchAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMatch, matchAttribute, matchUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetMatch, matchAttribute, matchUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:digit:]]+|option6?:[[:alnum:]-]+|vi-encap:[[:digit:]]+)(,.*)?$"),
+				`must be a DHCP option number or name, optionally followed by a value (e.g. "77,iPXE", or "option:client-arch,6")`,
+			),
+		},
+	}
+
+	networkIdSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       networkIdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetNetworkId, networkIdAttribute, networkIdUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetNetworkId, networkIdAttribute, networkIdUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[^[:space:],]+$"),
+				"must not contain whitespace or commas",
+			),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		dhcpOptionAttribute:               dhcpOptionSchemaAttribute,
+		forceAttribute:                    forceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		matchAttribute:                    matchSchemaAttribute,
+		networkIdAttribute:                networkIdSchemaAttribute,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	DHCPOption   types.Map    `tfsdk:"dhcp_option"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Force        types.Bool   `tfsdk:"force"`
+	Id           types.String `tfsdk:"id"`
+	Match        types.String `tfsdk:"match"`
+	NetworkId    types.String `tfsdk:"networkid"`
+}
+
+func modelGetDHCPOption(m model) types.Map   { return m.DHCPOption }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetForce(m model) types.Bool       { return m.Force }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetMatch(m model) types.String     { return m.Match }
+func modelGetNetworkId(m model) types.String { return m.NetworkId }
+
+func modelSetDHCPOption(m *model, value types.Map)   { m.DHCPOption = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetForce(m *model, value types.Bool)       { m.Force = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetMatch(m *model, value types.String)     { m.Match = value }
+func modelSetNetworkId(m *model, value types.String) { m.NetworkId = value }
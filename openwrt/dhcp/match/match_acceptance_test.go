@@ -0,0 +1,182 @@
+//go:build acceptance.test
+
+package match_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"dhcp_option": lucirpc.ListString([]string{"option:bootfile-name,ipxe.efi"}),
+		"match":       lucirpc.String("option:client-arch,7"),
+		"networkid":   lucirpc.String("efi64"),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "match", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_match" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_match.testing", "dhcp_option.%", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_match.testing", "dhcp_option.option:bootfile-name", "ipxe.efi"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_match.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_match.testing", "match", "option:client-arch,7"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_match.testing", "networkid", "efi64"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_match" "testing" {
+	id = "testing"
+	match = "77,iPXE"
+	networkid = "ipxe"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_match.testing", "dhcp_option"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "force", "false"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "match", "77,iPXE"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "networkid", "ipxe"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_match.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_match" "testing" {
+	dhcp_option = {
+		"option:bootfile-name" = "boot.ipxe"
+	}
+	force = true
+	id = "testing"
+	match = "175"
+	networkid = "ipxe"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "dhcp_option.%", "1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "dhcp_option.option:bootfile-name", "boot.ipxe"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "force", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "match", "175"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "networkid", "ipxe"),
+		),
+	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_match" "testing" {
+	dhcp_option = {
+		"option:bootfile-name" = "boot.ipxe"
+	}
+	force = true
+	id = "renamed"
+	match = "175"
+	networkid = "ipxe"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "id", "renamed"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "match", "175"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_match.testing", "networkid", "ipxe"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		renameAndReadResource,
+	)
+}
+
+func TestResourceInvalidMatchAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_match" "testing" {
+	id = "testing"
+	match = "client-arch"
+	networkid = "efi64"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,120 @@
+package mxhost
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	domainAttribute            = "domain"
+	domainAttributeDescription = "The domain to receive mail for. E.g. `example.com`."
+	domainUCIOption            = "domain"
+
+	preferenceAttribute            = "pref"
+	preferenceAttributeDescription = "The preference of this mail server. Lower values are preferred. If unset, `0` is used."
+	preferenceUCIOption            = "pref"
+
+	relayAttribute            = "relay"
+	relayAttributeDescription = "The mail server for the domain. E.g. `mail.example.com`."
+	relayUCIOption            = "relay"
+
+	schemaDescription = "A mail server (MX record) for a domain."
+	schemaVersion     = 0
+
+	uciConfig = "dhcp"
+	uciType   = "mxhost"
+)
+
+var (
+	domainNameValidators = []validator.String{
+		stringvalidator.RegexMatches(
+			regexp.MustCompile("^[^[:space:],/]+$"),
+			"must not contain whitespace, commas, or slashes",
+		),
+	}
+
+	domainSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       domainAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDomain, domainAttribute, domainUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDomain, domainAttribute, domainUCIOption),
+		Validators:        domainNameValidators,
+	}
+
+	preferenceSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       preferenceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetPreference, preferenceAttribute, preferenceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetPreference, preferenceAttribute, preferenceUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+		},
+	}
+
+	relaySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       relayAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetRelay, relayAttribute, relayUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetRelay, relayAttribute, relayUCIOption),
+		Validators:        domainNameValidators,
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		domainAttribute:                   domainSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		preferenceAttribute:               preferenceSchemaAttribute,
+		relayAttribute:                    relaySchemaAttribute,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Domain       types.String `tfsdk:"domain"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Preference   types.Int64  `tfsdk:"pref"`
+	Relay        types.String `tfsdk:"relay"`
+}
+
+func modelGetDomain(m model) types.String    { return m.Domain }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetPreference(m model) types.Int64 { return m.Preference }
+func modelGetRelay(m model) types.String     { return m.Relay }
+
+func modelSetDomain(m *model, value types.String)    { m.Domain = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetPreference(m *model, value types.Int64) { m.Preference = value }
+func modelSetRelay(m *model, value types.String)     { m.Relay = value }
@@ -0,0 +1,173 @@
+//go:build acceptance.test
+
+package mxhost_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"domain": lucirpc.String("example.com"),
+		"pref":   lucirpc.Integer(10),
+		"relay":  lucirpc.String("mail.example.com"),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "mxhost", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_mxhost" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_mxhost.testing", "domain", "example.com"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_mxhost.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_mxhost.testing", "pref", "10"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_mxhost.testing", "relay", "mail.example.com"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_mxhost" "testing" {
+	domain = "example.com"
+	id = "testing"
+	relay = "mail.example.com"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "domain", "example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_mxhost.testing", "pref"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "relay", "mail.example.com"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_mxhost.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_mxhost" "testing" {
+	domain = "example.com"
+	id = "testing"
+	pref = 20
+	relay = "backup.example.com"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "domain", "example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "pref", "20"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "relay", "backup.example.com"),
+		),
+	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_mxhost" "testing" {
+	domain = "example.com"
+	id = "renamed"
+	pref = 20
+	relay = "backup.example.com"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "domain", "example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "id", "renamed"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_mxhost.testing", "pref", "20"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		renameAndReadResource,
+	)
+}
+
+func TestResourceInvalidPreferenceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_mxhost" "testing" {
+	domain = "example.com"
+	id = "testing"
+	pref = 70000
+	relay = "mail.example.com"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
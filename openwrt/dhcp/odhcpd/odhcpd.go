@@ -4,6 +4,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
@@ -20,18 +22,22 @@ const (
 	leaseTriggerUCIOption            = "leasetrigger"
 
 	legacyAttribute            = "legacy"
-	legacyAttributeDescription = "Enable DHCPv4 if the 'dhcp' section constains a `start` option, but no `dhcpv4` option set."
+	legacyAttributeDescription = "Enable DHCPv4 if the 'dhcp' section constains a `start` option, but no `dhcpv4` option set. Defaults to `false`."
+	legacyDefaultValue         = false
 	legacyUCIOption            = "legacy"
 
 	logLevelAttribute            = "loglevel"
-	logLevelAttributeDescription = "Syslog level priority (0-7)."
+	logLevelAttributeDescription = "Syslog level priority (0-7). Defaults to `6`."
+	logLevelDefaultValue         = 6
 	logLevelUCIOption            = "loglevel"
 
 	mainDHCPAttribute            = "maindhcp"
-	mainDHCPAttributeDescription = "Use odhcpd as the main DHCPv4 service."
+	mainDHCPAttributeDescription = "Use odhcpd as the main DHCPv4 service. Defaults to `false`."
+	mainDHCPDefaultValue         = false
 	mainDHCPUCIOption            = "maindhcp"
 
 	schemaDescription = "An embedded DHCP/DHCPv6/RA server & NDP relay."
+	schemaVersion     = 0
 
 	uciConfig = "dhcp"
 	uciType   = "odhcpd"
@@ -53,6 +59,7 @@ var (
 	}
 
 	legacySchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(legacyDefaultValue),
 		Description:       legacyAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetLegacy, legacyAttribute, legacyUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -60,6 +67,7 @@ var (
 	}
 
 	logLevelSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(logLevelDefaultValue),
 		Description:       logLevelAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetLogLevel, logLevelAttribute, logLevelUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -70,6 +78,7 @@ var (
 	}
 
 	mainDHCPSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(mainDHCPDefaultValue),
 		Description:       mainDHCPAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetMainDHCP, mainDHCPAttribute, mainDHCPUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -77,12 +86,13 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		leaseFileAttribute:      leaseFileSchemaAttribute,
-		leaseTriggerAttribute:   leaseTriggerSchemaAttribute,
-		legacyAttribute:         legacySchemaAttribute,
-		logLevelAttribute:       logLevelSchemaAttribute,
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		mainDHCPAttribute:       mainDHCPSchemaAttribute,
+		leaseFileAttribute:                leaseFileSchemaAttribute,
+		leaseTriggerAttribute:             leaseTriggerSchemaAttribute,
+		legacyAttribute:                   legacySchemaAttribute,
+		logLevelAttribute:                 logLevelSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		mainDHCPAttribute:                 mainDHCPSchemaAttribute,
 	}
 )
 
@@ -98,15 +108,19 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
 	Id           types.String `tfsdk:"id"`
 	LeaseFile    types.String `tfsdk:"leasefile"`
 	LeaseTrigger types.String `tfsdk:"leasetrigger"`
@@ -115,6 +129,7 @@ type model struct {
 	MainDHCP     types.Bool   `tfsdk:"maindhcp"`
 }
 
+func modelGetExtraOptions(m model) types.Map    { return m.ExtraOptions }
 func modelGetId(m model) types.String           { return m.Id }
 func modelGetLeaseFile(m model) types.String    { return m.LeaseFile }
 func modelGetLeaseTrigger(m model) types.String { return m.LeaseTrigger }
@@ -122,6 +137,7 @@ func modelGetLegacy(m model) types.Bool         { return m.Legacy }
 func modelGetLogLevel(m model) types.Int64      { return m.LogLevel }
 func modelGetMainDHCP(m model) types.Bool       { return m.MainDHCP }
 
+func modelSetExtraOptions(m *model, value types.Map)    { m.ExtraOptions = value }
 func modelSetId(m *model, value types.String)           { m.Id = value }
 func modelSetLeaseFile(m *model, value types.String)    { m.LeaseFile = value }
 func modelSetLeaseTrigger(m *model, value types.String) { m.LeaseTrigger = value }
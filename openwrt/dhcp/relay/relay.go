@@ -0,0 +1,125 @@
+package relay
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	interfaceAttribute            = "interface"
+	interfaceAttributeDescription = "The interface the DHCP server is reached through. This name is what the interface is known as in UCI, or the `id` field in Terraform."
+	interfaceUCIOption            = "interface"
+
+	localAddressAttribute            = "local_addr"
+	localAddressAttributeDescription = "An address of this router. DHCP requests arriving on the interface with this address are relayed."
+	localAddressUCIOption            = "local_addr"
+
+	schemaDescription = "Relays DHCP requests to a DHCP server on another network."
+	schemaVersion     = 0
+
+	serverAddressAttribute            = "server_addr"
+	serverAddressAttributeDescription = "The address of the DHCP server to relay requests to."
+	serverAddressUCIOption            = "server_addr"
+
+	uciConfig = "dhcp"
+	uciType   = "relay"
+)
+
+var (
+	addressValidators = []validator.String{
+		stringvalidator.Any(
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}$`),
+				`must be a valid IPv4 address (e.g. "192.168.1.1")`,
+			),
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:xdigit:]:]*:[[:xdigit:]:]*$"),
+				`must be a valid IPv6 address (e.g. "fd12:3456:789a::1")`,
+			),
+		),
+	}
+
+	interfaceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       interfaceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetInterface, interfaceAttribute, interfaceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetInterface, interfaceAttribute, interfaceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	localAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       localAddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLocalAddress, localAddressAttribute, localAddressUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetLocalAddress, localAddressAttribute, localAddressUCIOption),
+		Validators:        addressValidators,
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		interfaceAttribute:                interfaceSchemaAttribute,
+		localAddressAttribute:             localAddressSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		serverAddressAttribute:            serverAddressSchemaAttribute,
+	}
+
+	serverAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       serverAddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetServerAddress, serverAddressAttribute, serverAddressUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetServerAddress, serverAddressAttribute, serverAddressUCIOption),
+		Validators:        addressValidators,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	ExtraOptions  types.Map    `tfsdk:"extra_options"`
+	Id            types.String `tfsdk:"id"`
+	Interface     types.String `tfsdk:"interface"`
+	LocalAddress  types.String `tfsdk:"local_addr"`
+	ServerAddress types.String `tfsdk:"server_addr"`
+}
+
+func modelGetExtraOptions(m model) types.Map     { return m.ExtraOptions }
+func modelGetId(m model) types.String            { return m.Id }
+func modelGetInterface(m model) types.String     { return m.Interface }
+func modelGetLocalAddress(m model) types.String  { return m.LocalAddress }
+func modelGetServerAddress(m model) types.String { return m.ServerAddress }
+
+func modelSetExtraOptions(m *model, value types.Map)     { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)            { m.Id = value }
+func modelSetInterface(m *model, value types.String)     { m.Interface = value }
+func modelSetLocalAddress(m *model, value types.String)  { m.LocalAddress = value }
+func modelSetServerAddress(m *model, value types.String) { m.ServerAddress = value }
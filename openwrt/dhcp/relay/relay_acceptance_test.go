@@ -0,0 +1,171 @@
+//go:build acceptance.test
+
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"interface":   lucirpc.String("wan"),
+		"local_addr":  lucirpc.String("192.168.1.1"),
+		"server_addr": lucirpc.String("10.0.0.2"),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "relay", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_relay" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_relay.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_relay.testing", "interface", "wan"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_relay.testing", "local_addr", "192.168.1.1"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_relay.testing", "server_addr", "10.0.0.2"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_relay" "testing" {
+	id = "testing"
+	local_addr = "192.168.1.1"
+	server_addr = "10.0.0.2"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_relay.testing", "interface"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "local_addr", "192.168.1.1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "server_addr", "10.0.0.2"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_relay.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_relay" "testing" {
+	id = "testing"
+	interface = "wan"
+	local_addr = "fd12:3456:789a::1"
+	server_addr = "fd00::2"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "interface", "wan"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "local_addr", "fd12:3456:789a::1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "server_addr", "fd00::2"),
+		),
+	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_relay" "testing" {
+	id = "renamed"
+	interface = "wan"
+	local_addr = "fd12:3456:789a::1"
+	server_addr = "fd00::2"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "id", "renamed"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_relay.testing", "server_addr", "fd00::2"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		renameAndReadResource,
+	)
+}
+
+func TestResourceInvalidServerAddressAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_relay" "testing" {
+	id = "testing"
+	local_addr = "192.168.1.1"
+	server_addr = "dhcp.example.com"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
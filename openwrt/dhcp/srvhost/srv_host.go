@@ -0,0 +1,161 @@
+package srvhost
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	portAttribute            = "port"
+	portAttributeDescription = "The port the service listens on."
+	portUCIOption            = "port"
+
+	priorityAttribute            = "class"
+	priorityAttributeDescription = "The priority of the target. Lower values are preferred. If unset, `0` is used."
+	priorityUCIOption            = "class"
+
+	schemaDescription = "Binds a service (SRV record) to a host and port."
+	schemaVersion     = 0
+
+	serviceAttribute            = "srv"
+	serviceAttributeDescription = "The service, protocol, and domain. E.g. `_ldap._tcp.example.com`."
+	serviceUCIOption            = "srv"
+
+	targetAttribute            = "target"
+	targetAttributeDescription = "The host providing the service. E.g. `ldap.example.com`."
+	targetUCIOption            = "target"
+
+	uciConfig = "dhcp"
+	uciType   = "srvhost"
+
+	weightAttribute            = "weight"
+	weightAttributeDescription = "The relative weight of targets with the same priority. Higher values are chosen more often. Requires `class`."
+	weightUCIOption            = "weight"
+)
+
+var (
+	portSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       portAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetPort, portAttribute, portUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetPort, portAttribute, portUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+		},
+	}
+
+	prioritySchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       priorityAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetPriority, priorityAttribute, priorityUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetPriority, priorityAttribute, priorityUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		portAttribute:                     portSchemaAttribute,
+		priorityAttribute:                 prioritySchemaAttribute,
+		serviceAttribute:                  serviceSchemaAttribute,
+		targetAttribute:                   targetSchemaAttribute,
+		weightAttribute:                   weightSchemaAttribute,
+	}
+
+	serviceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       serviceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetService, serviceAttribute, serviceUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetService, serviceAttribute, serviceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^_[^[:space:],/]+$"),
+				`must start with an underscore and not contain whitespace, commas, or slashes (e.g. "_ldap._tcp.example.com")`,
+			),
+		},
+	}
+
+	targetSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       targetAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTarget, targetAttribute, targetUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTarget, targetAttribute, targetUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[^[:space:],/]+$"),
+				"must not contain whitespace, commas, or slashes",
+			),
+		},
+	}
+
+	weightSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       weightAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetWeight, weightAttribute, weightUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetWeight, weightAttribute, weightUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+			int64validator.AlsoRequires(path.MatchRoot(priorityAttribute)),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Port         types.Int64  `tfsdk:"port"`
+	Priority     types.Int64  `tfsdk:"class"`
+	Service      types.String `tfsdk:"srv"`
+	Target       types.String `tfsdk:"target"`
+	Weight       types.Int64  `tfsdk:"weight"`
+}
+
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetPort(m model) types.Int64       { return m.Port }
+func modelGetPriority(m model) types.Int64   { return m.Priority }
+func modelGetService(m model) types.String   { return m.Service }
+func modelGetTarget(m model) types.String    { return m.Target }
+func modelGetWeight(m model) types.Int64     { return m.Weight }
+
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetPort(m *model, value types.Int64)       { m.Port = value }
+func modelSetPriority(m *model, value types.Int64)   { m.Priority = value }
+func modelSetService(m *model, value types.String)   { m.Service = value }
+func modelSetTarget(m *model, value types.String)    { m.Target = value }
+func modelSetWeight(m *model, value types.Int64)     { m.Weight = value }
@@ -0,0 +1,187 @@
+//go:build acceptance.test
+
+package srvhost_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"class":  lucirpc.Integer(10),
+		"port":   lucirpc.Integer(389),
+		"srv":    lucirpc.String("_ldap._tcp.example.com"),
+		"target": lucirpc.String("ldap.example.com"),
+		"weight": lucirpc.Integer(50),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "srvhost", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_srvhost" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_srvhost.testing", "class", "10"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_srvhost.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_srvhost.testing", "port", "389"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_srvhost.testing", "srv", "_ldap._tcp.example.com"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_srvhost.testing", "target", "ldap.example.com"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_srvhost.testing", "weight", "50"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_srvhost" "testing" {
+	id = "testing"
+	port = 389
+	srv = "_ldap._tcp.example.com"
+	target = "ldap.example.com"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_srvhost.testing", "class"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "port", "389"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "srv", "_ldap._tcp.example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "target", "ldap.example.com"),
+			resource.TestCheckNoResourceAttr("openwrt_dhcp_srvhost.testing", "weight"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_srvhost.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_srvhost" "testing" {
+	class = 10
+	id = "testing"
+	port = 636
+	srv = "_ldaps._tcp.example.com"
+	target = "ldap.example.com"
+	weight = 50
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "class", "10"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "port", "636"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "srv", "_ldaps._tcp.example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "target", "ldap.example.com"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "weight", "50"),
+		),
+	}
+	renameAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_srvhost" "testing" {
+	class = 10
+	id = "renamed"
+	port = 636
+	srv = "_ldaps._tcp.example.com"
+	target = "ldap.example.com"
+	weight = 50
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "class", "10"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "id", "renamed"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_srvhost.testing", "port", "636"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		renameAndReadResource,
+	)
+}
+
+func TestResourceWeightWithoutClassAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_srvhost" "testing" {
+	id = "testing"
+	port = 389
+	srv = "_ldap._tcp.example.com"
+	target = "ldap.example.com"
+	weight = 50
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,93 @@
+package tag
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/internal/dhcpoption"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	dhcpOptionAttribute            = "dhcp_option"
+	dhcpOptionAttributeDescription = "DHCP options to send to clients with this tag. " + dhcpoption.Description
+	dhcpOptionUCIOption            = "dhcp_option"
+
+	forceAttribute            = "force"
+	forceAttributeDescription = "Send the DHCP options even if the client does not request them. Defaults to `false`."
+	forceDefaultValue         = false
+	forceUCIOption            = "force"
+
+	schemaDescription = "DHCP options for clients with a tag. The tag is the name of the section (the `id` field in Terraform). Tags are set by e.g. `openwrt_dhcp_host` or `openwrt_dhcp_match`."
+	schemaVersion     = 0
+
+	uciConfig = "dhcp"
+	uciType   = "tag"
+)
+
+var (
+	dhcpOptionSchemaAttribute = lucirpcglue.MapStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dhcpOptionAttributeDescription,
+		ReadResponse:      dhcpoption.ReadResponse(modelSetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     dhcpoption.UpsertRequest(modelGetDHCPOption, dhcpOptionAttribute, dhcpOptionUCIOption),
+		Validators:        dhcpoption.Validators(),
+	}
+
+	forceSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(forceDefaultValue),
+		Description:       forceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetForce, forceAttribute, forceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetForce, forceAttribute, forceUCIOption),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		dhcpOptionAttribute:               dhcpOptionSchemaAttribute,
+		forceAttribute:                    forceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	DHCPOption   types.Map    `tfsdk:"dhcp_option"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Force        types.Bool   `tfsdk:"force"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func modelGetDHCPOption(m model) types.Map   { return m.DHCPOption }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetForce(m model) types.Bool       { return m.Force }
+func modelGetId(m model) types.String        { return m.Id }
+
+func modelSetDHCPOption(m *model, value types.Map)   { m.DHCPOption = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetForce(m *model, value types.Bool)       { m.Force = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
@@ -0,0 +1,164 @@
+//go:build acceptance.test
+
+package tag_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"dhcp_option": lucirpc.ListString([]string{
+			"42,192.168.1.2,192.168.1.3",
+			"option:domain-search",
+			"vendor:MSFT,2,1i",
+		}),
+		"force": lucirpc.Boolean(true),
+	}
+	ok, err := client.CreateSection(ctx, "dhcp", "tag", "voip", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_dhcp_tag" "testing" {
+	id = "voip"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_tag.testing", "dhcp_option.%", "3"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_tag.testing", "dhcp_option.42", "192.168.1.2,192.168.1.3"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_tag.testing", "dhcp_option.option:domain-search", ""),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_tag.testing", "dhcp_option.vendor:MSFT,2", "1i"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_tag.testing", "force", "true"),
+			resource.TestCheckResourceAttr("data.openwrt_dhcp_tag.testing", "id", "voip"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_tag" "testing" {
+	dhcp_option = {
+		"3" = "192.168.1.1"
+		"option:ntp-server" = "192.168.1.2"
+	}
+	id = "voip"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "dhcp_option.%", "2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "dhcp_option.3", "192.168.1.1"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "dhcp_option.option:ntp-server", "192.168.1.2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "force", "false"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "id", "voip"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_dhcp_tag.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_tag" "testing" {
+	dhcp_option = {
+		"43" = "01:04:c0:a8:01:0a"
+		"option:ntp-server" = "192.168.1.2,192.168.1.3"
+	}
+	force = true
+	id = "voip"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "dhcp_option.%", "2"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "dhcp_option.43", "01:04:c0:a8:01:0a"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "dhcp_option.option:ntp-server", "192.168.1.2,192.168.1.3"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "force", "true"),
+			resource.TestCheckResourceAttr("openwrt_dhcp_tag.testing", "id", "voip"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceInvalidDHCPOptionAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_dhcp_tag" "testing" {
+	dhcp_option = {
+		"ntp-server" = "192.168.1.2"
+	}
+	id = "voip"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,196 @@
+package defaults
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	dropInvalidAttribute            = "drop_invalid"
+	dropInvalidAttributeDescription = "Drop invalid packets (e.g. not matching any active connection). Defaults to `false`."
+	dropInvalidDefaultValue         = false
+	dropInvalidUCIOption            = "drop_invalid"
+
+	flowOffloadingAttribute            = "flow_offloading"
+	flowOffloadingAttributeDescription = "Enable software flow offloading for routed connections. Defaults to `false`."
+	flowOffloadingDefaultValue         = false
+	flowOffloadingUCIOption            = "flow_offloading"
+
+	flowOffloadingHWAttribute            = "flow_offloading_hw"
+	flowOffloadingHWAttributeDescription = "Enable hardware flow offloading for routed connections. Requires `flow_offloading` to be enabled. Defaults to `false`."
+	flowOffloadingHWDefaultValue         = false
+	flowOffloadingHWUCIOption            = "flow_offloading_hw"
+
+	forwardAttribute            = "forward"
+	forwardAttributeDescription = `Default policy for forwarded traffic. Must be one of: "ACCEPT", "DROP", "REJECT". If unset, "REJECT" is used.`
+	forwardUCIOption            = "forward"
+
+	inputAttribute            = "input"
+	inputAttributeDescription = `Default policy for incoming traffic. Must be one of: "ACCEPT", "DROP", "REJECT". If unset, "REJECT" is used.`
+	inputUCIOption            = "input"
+
+	outputAttribute            = "output"
+	outputAttributeDescription = `Default policy for outgoing traffic. Must be one of: "ACCEPT", "DROP", "REJECT". If unset, "REJECT" is used.`
+	outputUCIOption            = "output"
+
+	policyAccept = "ACCEPT"
+	policyDrop   = "DROP"
+	policyReject = "REJECT"
+
+	schemaDescription = "Global firewall settings that do not belong to any specific zone. There is only one of these sections, so the existing one is used instead of creating another."
+	schemaVersion     = 0
+
+	synFloodAttribute            = "syn_flood"
+	synFloodAttributeDescription = "Enable SYN flood protection. Defaults to `false`."
+	synFloodDefaultValue         = false
+	synFloodUCIOption            = "syn_flood"
+
+	uciConfig = "firewall"
+	uciType   = "defaults"
+)
+
+var (
+	dropInvalidSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(dropInvalidDefaultValue),
+		Description:       dropInvalidAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetDropInvalid, dropInvalidAttribute, dropInvalidUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetDropInvalid, dropInvalidAttribute, dropInvalidUCIOption),
+	}
+
+	flowOffloadingSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(flowOffloadingDefaultValue),
+		Description:       flowOffloadingAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetFlowOffloading, flowOffloadingAttribute, flowOffloadingUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetFlowOffloading, flowOffloadingAttribute, flowOffloadingUCIOption),
+	}
+
+	flowOffloadingHWSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(flowOffloadingHWDefaultValue),
+		Description:       flowOffloadingHWAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetFlowOffloadingHW, flowOffloadingHWAttribute, flowOffloadingHWUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetFlowOffloadingHW, flowOffloadingHWAttribute, flowOffloadingHWUCIOption),
+		Validators: []validator.Bool{
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(flowOffloadingAttribute),
+				true,
+			),
+		},
+	}
+
+	forwardSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       forwardAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetForward, forwardAttribute, forwardUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetForward, forwardAttribute, forwardUCIOption),
+		Validators:        policyValidators,
+	}
+
+	inputSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       inputAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetInput, inputAttribute, inputUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetInput, inputAttribute, inputUCIOption),
+		Validators:        policyValidators,
+	}
+
+	outputSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       outputAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetOutput, outputAttribute, outputUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetOutput, outputAttribute, outputUCIOption),
+		Validators:        policyValidators,
+	}
+
+	policyValidators = []validator.String{
+		stringvalidator.OneOf(
+			policyAccept,
+			policyDrop,
+			policyReject,
+		),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		dropInvalidAttribute:              dropInvalidSchemaAttribute,
+		flowOffloadingAttribute:           flowOffloadingSchemaAttribute,
+		flowOffloadingHWAttribute:         flowOffloadingHWSchemaAttribute,
+		forwardAttribute:                  forwardSchemaAttribute,
+		inputAttribute:                    inputSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.SingletonIdSchemaAttribute(modelGetId, modelSetId),
+		outputAttribute:                   outputSchemaAttribute,
+		synFloodAttribute:                 synFloodSchemaAttribute,
+	}
+
+	synFloodSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(synFloodDefaultValue),
+		Description:       synFloodAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetSynFlood, synFloodAttribute, synFloodUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetSynFlood, synFloodAttribute, synFloodUCIOption),
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewSingletonDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewSingletonResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	DropInvalid      types.Bool   `tfsdk:"drop_invalid"`
+	ExtraOptions     types.Map    `tfsdk:"extra_options"`
+	FlowOffloading   types.Bool   `tfsdk:"flow_offloading"`
+	FlowOffloadingHW types.Bool   `tfsdk:"flow_offloading_hw"`
+	Forward          types.String `tfsdk:"forward"`
+	Id               types.String `tfsdk:"id"`
+	Input            types.String `tfsdk:"input"`
+	Output           types.String `tfsdk:"output"`
+	SynFlood         types.Bool   `tfsdk:"syn_flood"`
+}
+
+func modelGetDropInvalid(m model) types.Bool      { return m.DropInvalid }
+func modelGetExtraOptions(m model) types.Map      { return m.ExtraOptions }
+func modelGetFlowOffloading(m model) types.Bool   { return m.FlowOffloading }
+func modelGetFlowOffloadingHW(m model) types.Bool { return m.FlowOffloadingHW }
+func modelGetForward(m model) types.String        { return m.Forward }
+func modelGetId(m model) types.String             { return m.Id }
+func modelGetInput(m model) types.String          { return m.Input }
+func modelGetOutput(m model) types.String         { return m.Output }
+func modelGetSynFlood(m model) types.Bool         { return m.SynFlood }
+
+func modelSetDropInvalid(m *model, value types.Bool)      { m.DropInvalid = value }
+func modelSetExtraOptions(m *model, value types.Map)      { m.ExtraOptions = value }
+func modelSetFlowOffloading(m *model, value types.Bool)   { m.FlowOffloading = value }
+func modelSetFlowOffloadingHW(m *model, value types.Bool) { m.FlowOffloadingHW = value }
+func modelSetForward(m *model, value types.String)        { m.Forward = value }
+func modelSetId(m *model, value types.String)             { m.Id = value }
+func modelSetInput(m *model, value types.String)          { m.Input = value }
+func modelSetOutput(m *model, value types.String)         { m.Output = value }
+func modelSetSynFlood(m *model, value types.Bool)         { m.SynFlood = value }
@@ -0,0 +1,222 @@
+//go:build acceptance.test
+
+package defaults_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	readDataSource := resource.TestStep{
+		// The default firewall configuration has a single anonymous defaults section.
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_defaults" "this" {
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("data.openwrt_firewall_defaults.this", "id", regexp.MustCompile("^cfg[[:xdigit:]]+$")),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_defaults.this", "output", "ACCEPT"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_defaults.this", "syn_flood", "true"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	adoptAndReadResource := resource.TestStep{
+		// The default firewall configuration has a single anonymous defaults section.
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_defaults" "this" {
+	drop_invalid = true
+	forward = "REJECT"
+	input = "REJECT"
+	output = "ACCEPT"
+	syn_flood = true
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_firewall_defaults.this", "id", regexp.MustCompile("^defaults_[[:xdigit:]]{8}$")),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "drop_invalid", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "flow_offloading", "false"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "flow_offloading_hw", "false"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "forward", "REJECT"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "input", "REJECT"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "output", "ACCEPT"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "syn_flood", "true"),
+		),
+	}
+	renameAndUpdateResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_defaults" "this" {
+	drop_invalid = true
+	flow_offloading = true
+	forward = "DROP"
+	id = "defaults"
+	input = "DROP"
+	output = "ACCEPT"
+	syn_flood = true
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "id", "defaults"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "flow_offloading", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "forward", "DROP"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "input", "DROP"),
+		),
+	}
+	readFirstSection := resource.TestStep{
+		// The resource should have adopted the stock section rather than adding another one.
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_defaults" "this" {
+}
+
+resource "openwrt_firewall_defaults" "this" {
+	drop_invalid = true
+	flow_offloading = true
+	forward = "DROP"
+	id = "defaults"
+	input = "DROP"
+	output = "ACCEPT"
+	syn_flood = true
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttrPair("data.openwrt_firewall_defaults.this", "id", "openwrt_firewall_defaults.this", "id"),
+			resource.TestCheckResourceAttrPair("data.openwrt_firewall_defaults.this", "forward", "openwrt_firewall_defaults.this", "forward"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		adoptAndReadResource,
+		renameAndUpdateResource,
+		readFirstSection,
+	)
+}
+
+func TestResourceImportAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	importAnonymousSection := resource.TestStep{
+		// The default firewall configuration has a single anonymous defaults section.
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_defaults" "this" {
+	forward = "REJECT"
+	input = "REJECT"
+	output = "ACCEPT"
+	syn_flood = true
+}
+`,
+			providerBlock,
+		),
+		ImportState:        true,
+		ImportStateId:      "@defaults[0]",
+		ImportStatePersist: true,
+		ResourceName:       "openwrt_firewall_defaults.this",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_defaults" "this" {
+	drop_invalid = true
+	forward = "DROP"
+	input = "DROP"
+	output = "ACCEPT"
+	syn_flood = true
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_firewall_defaults.this", "id", regexp.MustCompile("^defaults_[[:xdigit:]]{8}$")),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "drop_invalid", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "forward", "DROP"),
+			resource.TestCheckResourceAttr("openwrt_firewall_defaults.this", "input", "DROP"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		importAnonymousSection,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceHardwareOffloadingWithoutSoftwareOffloadingAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_defaults" "this" {
+	flow_offloading_hw = true
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,132 @@
+package forwarding
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	destinationAttribute            = "dest"
+	destinationAttributeDescription = "Name of the zone traffic is forwarded to. This is the `name` field of a firewall zone in Terraform. Must be different from `src`."
+	destinationUCIOption            = "dest"
+
+	familyAttribute            = "family"
+	familyAttributeDescription = `The protocol family this forwarding applies to. Must be one of: "any", "ipv4", "ipv6". Defaults to "any".`
+	familyAny                  = "any"
+	familyDefaultValue         = familyAny
+	familyIPv4                 = "ipv4"
+	familyIPv6                 = "ipv6"
+	familyUCIOption            = "family"
+
+	schemaDescription = "Allows traffic to be forwarded from one firewall zone to another."
+	schemaVersion     = 0
+
+	sourceAttribute            = "src"
+	sourceAttributeDescription = "Name of the zone traffic is forwarded from. This is the `name` field of a firewall zone in Terraform. Must be different from `dest`."
+	sourceUCIOption            = "src"
+
+	uciConfig = "firewall"
+	uciType   = "forwarding"
+)
+
+var (
+	configValidators = []resource.ConfigValidator{
+		lucirpcglue.Distinct(
+			path.MatchRoot(destinationAttribute),
+			path.MatchRoot(sourceAttribute),
+		),
+	}
+
+	destinationSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destinationAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDestination, destinationAttribute, destinationUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDestination, destinationAttribute, destinationUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	familySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(familyDefaultValue),
+		Description:       familyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetFamily, familyAttribute, familyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetFamily, familyAttribute, familyUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				familyAny,
+				familyIPv4,
+				familyIPv6,
+			),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		destinationAttribute:              destinationSchemaAttribute,
+		familyAttribute:                   familySchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		sourceAttribute:                   sourceSchemaAttribute,
+	}
+
+	sourceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       sourceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSource, sourceAttribute, sourceUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSource, sourceAttribute, sourceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		configValidators,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Destination  types.String `tfsdk:"dest"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Family       types.String `tfsdk:"family"`
+	Id           types.String `tfsdk:"id"`
+	Source       types.String `tfsdk:"src"`
+}
+
+func modelGetDestination(m model) types.String { return m.Destination }
+func modelGetExtraOptions(m model) types.Map   { return m.ExtraOptions }
+func modelGetFamily(m model) types.String      { return m.Family }
+func modelGetId(m model) types.String          { return m.Id }
+func modelGetSource(m model) types.String      { return m.Source }
+
+func modelSetDestination(m *model, value types.String) { m.Destination = value }
+func modelSetExtraOptions(m *model, value types.Map)   { m.ExtraOptions = value }
+func modelSetFamily(m *model, value types.String)      { m.Family = value }
+func modelSetId(m *model, value types.String)          { m.Id = value }
+func modelSetSource(m *model, value types.String)      { m.Source = value }
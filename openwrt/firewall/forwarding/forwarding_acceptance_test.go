@@ -0,0 +1,150 @@
+//go:build acceptance.test
+
+package forwarding_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"dest": lucirpc.String("wan"),
+		"src":  lucirpc.String("lan"),
+	}
+	ok, err := client.CreateSection(ctx, "firewall", "forwarding", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_forwarding" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_firewall_forwarding.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_forwarding.testing", "dest", "wan"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_forwarding.testing", "src", "lan"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_forwarding" "testing" {
+	dest = "wan"
+	id = "testing"
+	src = "lan"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "dest", "wan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "family", "any"),
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "src", "lan"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_forwarding.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_forwarding" "testing" {
+	dest = "wan"
+	family = "ipv6"
+	id = "testing"
+	src = "guest"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "dest", "wan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "family", "ipv6"),
+			resource.TestCheckResourceAttr("openwrt_firewall_forwarding.testing", "src", "guest"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceSameZoneAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_forwarding" "testing" {
+	dest = "lan"
+	id = "testing"
+	src = "lan"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
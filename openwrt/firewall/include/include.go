@@ -0,0 +1,216 @@
+package include
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	chainAttribute            = "chain"
+	chainAttributeDescription = `Name of the chain the snippet is included in (e.g. "input_wan"). Required when "position" is "chain-pre" or "chain-post".`
+	chainUCIOption            = "chain"
+
+	enabledAttribute            = "enabled"
+	enabledAttributeDescription = "Whether the include is enabled. Defaults to `true`."
+	enabledDefaultValue         = true
+	enabledUCIOption            = "enabled"
+
+	pathAttribute            = "path"
+	pathAttributeDescription = "Absolute path to the file on the device."
+	pathUCIOption            = "path"
+
+	positionAttribute            = "position"
+	positionAttributeDescription = `Where the nftables snippet is included in the ruleset. Must be one of: "chain-post", "chain-pre", "ruleset-post", "ruleset-pre", "table-post", "table-pre". Only used when "type" is "nftables". If unset, "table-post" is used.`
+	positionChainPost            = "chain-post"
+	positionChainPre             = "chain-pre"
+	positionRulesetPost          = "ruleset-post"
+	positionRulesetPre           = "ruleset-pre"
+	positionTablePost            = "table-post"
+	positionTablePre             = "table-pre"
+	positionUCIOption            = "position"
+
+	schemaDescription = "A custom script or nftables snippet included in the firewall."
+	schemaVersion     = 0
+
+	typeAttribute            = "type"
+	typeAttributeDescription = `The kind of file being included. Must be one of: "nftables", "script". Defaults to "script".`
+	typeDefaultValue         = typeScript
+	typeNFTables             = "nftables"
+	typeScript               = "script"
+	typeUCIOption            = "type"
+
+	uciConfig = "firewall"
+	uciType   = "include"
+)
+
+var (
+	chainSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       chainAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetChain, chainAttribute, chainUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetChain, chainAttribute, chainUCIOption),
+		Validators: []validator.String{
+			stringvalidator.Any(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(positionAttribute),
+					positionChainPost,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(positionAttribute),
+					positionChainPre,
+				),
+			),
+		},
+	}
+
+	configValidators = []resource.ConfigValidator{
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(positionAttribute),
+			positionChainPost,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(chainAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(positionAttribute),
+			positionChainPre,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(chainAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(typeAttribute),
+			typeScript,
+			lucirpcglue.NoneOf(
+				path.MatchRoot(positionAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeUnset(
+			path.MatchRoot(typeAttribute),
+			lucirpcglue.NoneOf(
+				path.MatchRoot(positionAttribute),
+			),
+		),
+	}
+
+	enabledSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enabledDefaultValue),
+		Description:       enabledAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnabled, enabledAttribute, enabledUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetEnabled, enabledAttribute, enabledUCIOption),
+	}
+
+	pathSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       pathAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPath, pathAttribute, pathUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPath, pathAttribute, pathUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^/"),
+				`must be an absolute path (e.g. "/etc/firewall.user")`,
+			),
+		},
+	}
+
+	positionSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       positionAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPosition, positionAttribute, positionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPosition, positionAttribute, positionUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				positionChainPost,
+				positionChainPre,
+				positionRulesetPost,
+				positionRulesetPre,
+				positionTablePost,
+				positionTablePre,
+			),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		chainAttribute:                    chainSchemaAttribute,
+		enabledAttribute:                  enabledSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		pathAttribute:                     pathSchemaAttribute,
+		positionAttribute:                 positionSchemaAttribute,
+		typeAttribute:                     typeSchemaAttribute,
+	}
+
+	typeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(typeDefaultValue),
+		Description:       typeAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetType, typeAttribute, typeUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetType, typeAttribute, typeUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				typeNFTables,
+				typeScript,
+			),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		configValidators,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Chain        types.String `tfsdk:"chain"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Position     types.String `tfsdk:"position"`
+	Type         types.String `tfsdk:"type"`
+}
+
+func modelGetChain(m model) types.String     { return m.Chain }
+func modelGetEnabled(m model) types.Bool     { return m.Enabled }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetPath(m model) types.String      { return m.Path }
+func modelGetPosition(m model) types.String  { return m.Position }
+func modelGetType(m model) types.String      { return m.Type }
+
+func modelSetChain(m *model, value types.String)     { m.Chain = value }
+func modelSetEnabled(m *model, value types.Bool)     { m.Enabled = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetPath(m *model, value types.String)      { m.Path = value }
+func modelSetPosition(m *model, value types.String)  { m.Position = value }
+func modelSetType(m *model, value types.String)      { m.Type = value }
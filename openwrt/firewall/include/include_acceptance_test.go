@@ -0,0 +1,184 @@
+//go:build acceptance.test
+
+package include_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"path":     lucirpc.String("/etc/nftables.d/custom.nft"),
+		"position": lucirpc.String("table-post"),
+		"type":     lucirpc.String("nftables"),
+	}
+	ok, err := client.CreateSection(ctx, "firewall", "include", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_include" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_firewall_include.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_include.testing", "path", "/etc/nftables.d/custom.nft"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_include.testing", "position", "table-post"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_include.testing", "type", "nftables"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_include" "testing" {
+	id = "testing"
+	path = "/etc/firewall.user"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "enabled", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "path", "/etc/firewall.user"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "type", "script"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_include.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_include" "testing" {
+	chain = "input_wan"
+	id = "testing"
+	path = "/etc/nftables.d/input_wan.nft"
+	position = "chain-pre"
+	type = "nftables"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "chain", "input_wan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "path", "/etc/nftables.d/input_wan.nft"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "position", "chain-pre"),
+			resource.TestCheckResourceAttr("openwrt_firewall_include.testing", "type", "nftables"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceChainPositionWithoutChainAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_include" "testing" {
+	id = "testing"
+	path = "/etc/nftables.d/input_wan.nft"
+	position = "chain-pre"
+	type = "nftables"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceScriptWithPositionAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_include" "testing" {
+	id = "testing"
+	path = "/etc/firewall.user"
+	position = "table-post"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,52 @@
+// Package protocol defines the protocols fw4 accepts in the `proto` option of firewall rules and redirects.
+package protocol
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// Description explains the values of the `proto` attribute.
+	Description = `Protocols to match, by name or number. Names must be one of: "ah", "all", "esp", "icmp", "icmpv6", "sctp", "tcp", "tcpudp", "udp", "udplite". If unset, "tcpudp" is used.`
+
+	AH      = "ah"
+	All     = "all"
+	ESP     = "esp"
+	ICMP    = "icmp"
+	ICMPv6  = "icmpv6"
+	SCTP    = "sctp"
+	TCP     = "tcp"
+	TCPUDP  = "tcpudp"
+	UDP     = "udp"
+	UDPLite = "udplite"
+)
+
+var (
+	// Validators ensure each protocol is either a known name or a protocol number.
+	Validators = []validator.Set{
+		setvalidator.ValueStringsAre(
+			stringvalidator.Any(
+				stringvalidator.OneOf(
+					AH,
+					All,
+					ESP,
+					ICMP,
+					ICMPv6,
+					SCTP,
+					TCP,
+					TCPUDP,
+					UDP,
+					UDPLite,
+				),
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^([[:digit:]]{1,2}|1[[:digit:]]{2}|2[0-4][[:digit:]]|25[0-5])$"),
+					"must be a protocol number between 0 and 255",
+				),
+			),
+		),
+	}
+)
@@ -0,0 +1,170 @@
+package ipset
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	entriesAttribute            = "entry"
+	entriesAttributeDescription = "Entries in the set. Each entry must match the `match` attribute (e.g. an IP address for `ip`)."
+	entriesUCIOption            = "entry"
+
+	familyAttribute            = "family"
+	familyAttributeDescription = `The protocol family of the set. Must be one of: "ipv4", "ipv6". Defaults to "ipv4".`
+	familyDefaultValue         = familyIPv4
+	familyIPv4                 = "ipv4"
+	familyIPv6                 = "ipv6"
+	familyUCIOption            = "family"
+
+	loadFileAttribute            = "loadfile"
+	loadFileAttributeDescription = "Path to a file on the device to load additional entries from. One entry per line."
+	loadFileUCIOption            = "loadfile"
+
+	matchAttribute            = "match"
+	matchAttributeDescription = `What the set matches on, in order. Each must be a type (one of: "ip", "mac", "net", "port", "set"), optionally prefixed with a direction (one of: "dest_", "src_"). E.g. "src_ip", "dest_port".`
+	matchUCIOption            = "match"
+
+	nameAttribute            = "name"
+	nameAttributeDescription = "Unique name of the set. This is how other firewall sections (e.g. rules, redirects) refer to this set."
+	nameUCIOption            = "name"
+
+	schemaDescription = "A named set of addresses, networks, ports, or MAC addresses that firewall rules can match against."
+	schemaVersion     = 0
+
+	uciConfig = "firewall"
+	uciType   = "ipset"
+)
+
+var (
+	entriesSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       entriesAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetEntries, entriesAttribute, entriesUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetEntries, entriesAttribute, entriesUCIOption),
+	}
+
+	familySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(familyDefaultValue),
+		Description:       familyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetFamily, familyAttribute, familyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetFamily, familyAttribute, familyUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				familyIPv4,
+				familyIPv6,
+			),
+		},
+	}
+
+	loadFileSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       loadFileAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLoadFile, loadFileAttribute, loadFileUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetLoadFile, loadFileAttribute, loadFileUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^/"),
+				`must be an absolute path (e.g. "/etc/blocklist.txt")`,
+			),
+		},
+	}
+
+	matchSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       matchAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetMatch, matchAttribute, matchUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetMatch, matchAttribute, matchUCIOption),
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^((dest|src)_)?(ip|mac|net|port|set)$"),
+					`must be a type, optionally prefixed with a direction (e.g. "src_ip", "dest_port")`,
+				),
+			),
+		},
+	}
+
+	nameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetName, nameAttribute, nameUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetName, nameAttribute, nameUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:alnum:]_]+$"),
+				"must only contain letters, numbers, and underscores",
+			),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		entriesAttribute:                  entriesSchemaAttribute,
+		familyAttribute:                   familySchemaAttribute,
+		loadFileAttribute:                 loadFileSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		matchAttribute:                    matchSchemaAttribute,
+		nameAttribute:                     nameSchemaAttribute,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Entries      types.Set    `tfsdk:"entry"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Family       types.String `tfsdk:"family"`
+	Id           types.String `tfsdk:"id"`
+	LoadFile     types.String `tfsdk:"loadfile"`
+	Match        types.List   `tfsdk:"match"`
+	Name         types.String `tfsdk:"name"`
+}
+
+func modelGetEntries(m model) types.Set      { return m.Entries }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetFamily(m model) types.String    { return m.Family }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetLoadFile(m model) types.String  { return m.LoadFile }
+func modelGetMatch(m model) types.List       { return m.Match }
+func modelGetName(m model) types.String      { return m.Name }
+
+func modelSetEntries(m *model, value types.Set)      { m.Entries = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetFamily(m *model, value types.String)    { m.Family = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetLoadFile(m *model, value types.String)  { m.LoadFile = value }
+func modelSetMatch(m *model, value types.List)       { m.Match = value }
+func modelSetName(m *model, value types.String)      { m.Name = value }
@@ -0,0 +1,171 @@
+//go:build acceptance.test
+
+package ipset_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"entry": lucirpc.ListString([]string{"192.168.1.10", "192.168.1.11"}),
+		"match": lucirpc.ListString([]string{"src_ip"}),
+		"name":  lucirpc.String("management"),
+	}
+	ok, err := client.CreateSection(ctx, "firewall", "ipset", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_ipset" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_firewall_ipset.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_ipset.testing", "entry.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_ipset.testing", "match.#", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_ipset.testing", "match.0", "src_ip"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_ipset.testing", "name", "management"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_ipset" "testing" {
+	entry = [
+		"192.168.1.10",
+	]
+	id = "testing"
+	match = [
+		"src_ip",
+	]
+	name = "management"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "entry.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "entry.0", "192.168.1.10"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "family", "ipv4"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "match.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "match.0", "src_ip"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "name", "management"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_ipset.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_ipset" "testing" {
+	family = "ipv6"
+	id = "testing"
+	loadfile = "/etc/blocklist.txt"
+	match = [
+		"src_net",
+		"dest_port",
+	]
+	name = "blocklist"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_firewall_ipset.testing", "entry"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "family", "ipv6"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "loadfile", "/etc/blocklist.txt"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "match.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "match.0", "src_net"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "match.1", "dest_port"),
+			resource.TestCheckResourceAttr("openwrt_firewall_ipset.testing", "name", "blocklist"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceInvalidMatchAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_ipset" "testing" {
+	id = "testing"
+	match = [
+		"source_ip",
+	]
+	name = "testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,285 @@
+package redirect
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/internal/protocol"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	destAttribute            = "dest"
+	destAttributeDescription = `Name of the zone the traffic is redirected to. If unset, it is inferred from "dest_ip".`
+	destUCIOption            = "dest"
+
+	destIPAttribute            = "dest_ip"
+	destIPAttributeDescription = `Internal IP address the traffic is redirected to. Required when "target" is "DNAT".`
+	destIPUCIOption            = "dest_ip"
+
+	destPortAttribute            = "dest_port"
+	destPortAttributeDescription = `Internal port or port range the traffic is redirected to (e.g. "80", "8000-8080"). If unset, the external port is used.`
+	destPortUCIOption            = "dest_port"
+
+	enabledAttribute            = "enabled"
+	enabledAttributeDescription = "Whether the redirect is enabled. Defaults to `true`."
+	enabledDefaultValue         = true
+	enabledUCIOption            = "enabled"
+
+	nameAttribute            = "name"
+	nameAttributeDescription = "Human-readable name of the redirect."
+	nameUCIOption            = "name"
+
+	protocolsAttribute            = "proto"
+	protocolsAttributeDescription = protocol.Description
+	protocolsUCIOption            = "proto"
+
+	reflectionAttribute            = "reflection"
+	reflectionAttributeDescription = "Redirect traffic from internal hosts to the external address as well (NAT loopback). Defaults to `true`."
+	reflectionDefaultValue         = true
+	reflectionUCIOption            = "reflection"
+
+	schemaDescription = "A redirect of traffic from one zone to another. Typically used for port forwarding."
+	schemaVersion     = 0
+
+	srcAttribute            = "src"
+	srcAttributeDescription = "Name of the zone the traffic is coming from. Typically the wan zone."
+	srcUCIOption            = "src"
+
+	srcDIPAttribute            = "src_dip"
+	srcDIPAttributeDescription = `External IP address the traffic is rewritten to. Required when "target" is "SNAT".`
+	srcDIPUCIOption            = "src_dip"
+
+	srcDPortAttribute            = "src_dport"
+	srcDPortAttributeDescription = `External port or port range to match (e.g. "80", "8000-8080").`
+	srcDPortUCIOption            = "src_dport"
+
+	targetAttribute            = "target"
+	targetAttributeDescription = `The kind of NAT to perform. Must be one of: "DNAT", "SNAT". Defaults to "DNAT".`
+	targetDefaultValue         = targetDNAT
+	targetDNAT                 = "DNAT"
+	targetSNAT                 = "SNAT"
+	targetUCIOption            = "target"
+
+	uciConfig = "firewall"
+	uciType   = "redirect"
+)
+
+var (
+	configValidators = []resource.ConfigValidator{
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(targetAttribute),
+			targetDNAT,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(destIPAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(targetAttribute),
+			targetSNAT,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(srcDIPAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeUnset(
+			path.MatchRoot(targetAttribute),
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(destIPAttribute),
+			),
+		),
+	}
+
+	destSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDest, destAttribute, destUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDest, destAttribute, destUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	destIPSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destIPAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDestIP, destIPAttribute, destIPUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDestIP, destIPAttribute, destIPUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	destPortSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDestPort, destPortAttribute, destPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDestPort, destPortAttribute, destPortUCIOption),
+		Validators:        portValidators,
+	}
+
+	enabledSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enabledDefaultValue),
+		Description:       enabledAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnabled, enabledAttribute, enabledUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetEnabled, enabledAttribute, enabledUCIOption),
+	}
+
+	nameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetName, nameAttribute, nameUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetName, nameAttribute, nameUCIOption),
+	}
+
+	portValidators = []validator.String{
+		lucirpcglue.PortRangeString(),
+	}
+
+	protocolsSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       protocolsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetProtocols, protocolsAttribute, protocolsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetProtocols, protocolsAttribute, protocolsUCIOption),
+		Validators:        protocol.Validators,
+	}
+
+	reflectionSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(reflectionDefaultValue),
+		Description:       reflectionAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetReflection, reflectionAttribute, reflectionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetReflection, reflectionAttribute, reflectionUCIOption),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		destAttribute:                     destSchemaAttribute,
+		destIPAttribute:                   destIPSchemaAttribute,
+		destPortAttribute:                 destPortSchemaAttribute,
+		enabledAttribute:                  enabledSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		nameAttribute:                     nameSchemaAttribute,
+		protocolsAttribute:                protocolsSchemaAttribute,
+		reflectionAttribute:               reflectionSchemaAttribute,
+		srcAttribute:                      srcSchemaAttribute,
+		srcDIPAttribute:                   srcDIPSchemaAttribute,
+		srcDPortAttribute:                 srcDPortSchemaAttribute,
+		targetAttribute:                   targetSchemaAttribute,
+	}
+
+	srcSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       srcAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrc, srcAttribute, srcUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrc, srcAttribute, srcUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	srcDIPSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       srcDIPAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrcDIP, srcDIPAttribute, srcDIPUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrcDIP, srcDIPAttribute, srcDIPUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	srcDPortSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       srcDPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrcDPort, srcDPortAttribute, srcDPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrcDPort, srcDPortAttribute, srcDPortUCIOption),
+		Validators:        portValidators,
+	}
+
+	targetSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(targetDefaultValue),
+		Description:       targetAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTarget, targetAttribute, targetUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTarget, targetAttribute, targetUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				targetDNAT,
+				targetSNAT,
+			),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		configValidators,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Dest         types.String `tfsdk:"dest"`
+	DestIP       types.String `tfsdk:"dest_ip"`
+	DestPort     types.String `tfsdk:"dest_port"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Protocols    types.Set    `tfsdk:"proto"`
+	Reflection   types.Bool   `tfsdk:"reflection"`
+	Src          types.String `tfsdk:"src"`
+	SrcDIP       types.String `tfsdk:"src_dip"`
+	SrcDPort     types.String `tfsdk:"src_dport"`
+	Target       types.String `tfsdk:"target"`
+}
+
+func modelGetDest(m model) types.String      { return m.Dest }
+func modelGetDestIP(m model) types.String    { return m.DestIP }
+func modelGetDestPort(m model) types.String  { return m.DestPort }
+func modelGetEnabled(m model) types.Bool     { return m.Enabled }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetName(m model) types.String      { return m.Name }
+func modelGetProtocols(m model) types.Set    { return m.Protocols }
+func modelGetReflection(m model) types.Bool  { return m.Reflection }
+func modelGetSrc(m model) types.String       { return m.Src }
+func modelGetSrcDIP(m model) types.String    { return m.SrcDIP }
+func modelGetSrcDPort(m model) types.String  { return m.SrcDPort }
+func modelGetTarget(m model) types.String    { return m.Target }
+
+func modelSetDest(m *model, value types.String)      { m.Dest = value }
+func modelSetDestIP(m *model, value types.String)    { m.DestIP = value }
+func modelSetDestPort(m *model, value types.String)  { m.DestPort = value }
+func modelSetEnabled(m *model, value types.Bool)     { m.Enabled = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetName(m *model, value types.String)      { m.Name = value }
+func modelSetProtocols(m *model, value types.Set)    { m.Protocols = value }
+func modelSetReflection(m *model, value types.Bool)  { m.Reflection = value }
+func modelSetSrc(m *model, value types.String)       { m.Src = value }
+func modelSetSrcDIP(m *model, value types.String)    { m.SrcDIP = value }
+func modelSetSrcDPort(m *model, value types.String)  { m.SrcDPort = value }
+func modelSetTarget(m *model, value types.String)    { m.Target = value }
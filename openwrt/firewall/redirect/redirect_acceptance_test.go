@@ -0,0 +1,220 @@
+//go:build acceptance.test
+
+package redirect_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"dest":      lucirpc.String("lan"),
+		"dest_ip":   lucirpc.String("192.168.1.10"),
+		"dest_port": lucirpc.String("80"),
+		"name":      lucirpc.String("Forward-HTTP"),
+		"proto":     lucirpc.ListString([]string{"tcp"}),
+		"src":       lucirpc.String("wan"),
+		"src_dport": lucirpc.String("8080"),
+		"target":    lucirpc.String("DNAT"),
+	}
+	ok, err := client.CreateSection(ctx, "firewall", "redirect", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_redirect" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "dest", "lan"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "dest_ip", "192.168.1.10"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "dest_port", "80"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "name", "Forward-HTTP"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "proto.#", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "proto.0", "tcp"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "src", "wan"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "src_dport", "8080"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_redirect.testing", "target", "DNAT"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_redirect" "testing" {
+	dest = "lan"
+	dest_ip = "192.168.1.10"
+	dest_port = "80"
+	id = "testing"
+	name = "Forward-HTTP"
+	proto = [
+		"tcp",
+	]
+	src = "wan"
+	src_dport = "8080"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "dest", "lan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "dest_ip", "192.168.1.10"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "dest_port", "80"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "enabled", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "name", "Forward-HTTP"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "proto.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "proto.0", "tcp"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "reflection", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "src", "wan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "src_dport", "8080"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "target", "DNAT"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_redirect.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_redirect" "testing" {
+	dest = "wan"
+	id = "testing"
+	name = "SNAT-Outgoing"
+	proto = [
+		"all",
+	]
+	reflection = false
+	src = "lan"
+	src_dip = "203.0.113.1"
+	target = "SNAT"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "dest", "wan"),
+			resource.TestCheckNoResourceAttr("openwrt_firewall_redirect.testing", "dest_ip"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "name", "SNAT-Outgoing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "proto.0", "all"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "reflection", "false"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "src", "lan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "src_dip", "203.0.113.1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_redirect.testing", "target", "SNAT"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceDNATWithoutDestIPAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_redirect" "testing" {
+	id = "testing"
+	src = "wan"
+	src_dport = "8080"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceSNATWithoutSrcDIPAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_redirect" "testing" {
+	dest = "wan"
+	id = "testing"
+	src = "lan"
+	target = "SNAT"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
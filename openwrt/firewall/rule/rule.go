@@ -0,0 +1,324 @@
+package rule
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/internal/protocol"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	destAttribute            = "dest"
+	destAttributeDescription = `Name of the zone the traffic is going to. If unset, the rule applies to traffic destined for the router itself. Use "*" for any zone.`
+	destUCIOption            = "dest"
+
+	destIPAttribute            = "dest_ip"
+	destIPAttributeDescription = "Match traffic going to this IP address or CIDR range."
+	destIPUCIOption            = "dest_ip"
+
+	destPortAttribute            = "dest_port"
+	destPortAttributeDescription = `Match traffic going to this port or port range (e.g. "22", "1024-65535"). Ports must be between 1 and 65535.`
+	destPortUCIOption            = "dest_port"
+
+	enabledAttribute            = "enabled"
+	enabledAttributeDescription = "Whether the rule is enabled. Defaults to `true`."
+	enabledDefaultValue         = true
+	enabledUCIOption            = "enabled"
+
+	familyAttribute            = "family"
+	familyAttributeDescription = `The protocol family this rule applies to. Must be one of: "any", "ipv4", "ipv6". Defaults to "any".`
+	familyAny                  = "any"
+	familyDefaultValue         = familyAny
+	familyIPv4                 = "ipv4"
+	familyIPv6                 = "ipv6"
+	familyUCIOption            = "family"
+
+	icmpTypesAttribute            = "icmp_type"
+	icmpTypesAttributeDescription = `ICMP types to match, by name or number (e.g. "echo-request", "8"). Only used when the protocol is ICMP.`
+	icmpTypesUCIOption            = "icmp_type"
+
+	limitAttribute            = "limit"
+	limitAttributeDescription = `Maximum average rate of matching traffic (e.g. "10/second", "3/minute").`
+	limitUCIOption            = "limit"
+
+	nameAttribute            = "name"
+	nameAttributeDescription = "Human-readable name of the rule."
+	nameUCIOption            = "name"
+
+	protocolsAttribute            = "proto"
+	protocolsAttributeDescription = protocol.Description
+	protocolsUCIOption            = "proto"
+
+	schemaDescription = "A rule that accepts, rejects, or drops traffic matching certain criteria."
+	schemaVersion     = 0
+
+	srcAttribute            = "src"
+	srcAttributeDescription = `Name of the zone the traffic is coming from. If unset, the rule applies to traffic originating from the router itself. Use "*" for any zone.`
+	srcUCIOption            = "src"
+
+	srcIPAttribute            = "src_ip"
+	srcIPAttributeDescription = "Match traffic coming from this IP address or CIDR range."
+	srcIPUCIOption            = "src_ip"
+
+	srcPortAttribute            = "src_port"
+	srcPortAttributeDescription = `Match traffic coming from this port or port range (e.g. "22", "1024-65535"). Ports must be between 1 and 65535.`
+	srcPortUCIOption            = "src_port"
+
+	targetAttribute            = "target"
+	targetAttributeDescription = `What to do with matching traffic. Must be one of: "ACCEPT", "DROP", "MARK", "NOTRACK", "REJECT". Defaults to "DROP".`
+	targetAccept               = "ACCEPT"
+	targetDefaultValue         = targetDrop
+	targetDrop                 = "DROP"
+	targetMark                 = "MARK"
+	targetNoTrack              = "NOTRACK"
+	targetReject               = "REJECT"
+	targetUCIOption            = "target"
+
+	uciConfig = "firewall"
+	uciType   = "rule"
+)
+
+var (
+	destSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDest, destAttribute, destUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDest, destAttribute, destUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	destIPSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destIPAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDestIP, destIPAttribute, destIPUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDestIP, destIPAttribute, destIPUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	destPortSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       destPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDestPort, destPortAttribute, destPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDestPort, destPortAttribute, destPortUCIOption),
+		Validators:        portValidators,
+	}
+
+	enabledSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enabledDefaultValue),
+		Description:       enabledAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnabled, enabledAttribute, enabledUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetEnabled, enabledAttribute, enabledUCIOption),
+	}
+
+	familySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(familyDefaultValue),
+		Description:       familyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetFamily, familyAttribute, familyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetFamily, familyAttribute, familyUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				familyAny,
+				familyIPv4,
+				familyIPv6,
+			),
+		},
+	}
+
+	icmpTypesSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       icmpTypesAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetICMPTypes, icmpTypesAttribute, icmpTypesUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetICMPTypes, icmpTypesAttribute, icmpTypesUCIOption),
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[[:alnum:]-]+$"),
+					`must be an ICMP type name or number (e.g. "echo-request", "8")`,
+				),
+			),
+		},
+	}
+
+	limitSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       limitAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLimit, limitAttribute, limitUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetLimit, limitAttribute, limitUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:digit:]]+/(second|minute|hour|day)$"),
+				`must be a rate (e.g. "10/second", "3/minute")`,
+			),
+		},
+	}
+
+	nameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetName, nameAttribute, nameUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetName, nameAttribute, nameUCIOption),
+	}
+
+	portValidators = []validator.String{
+		lucirpcglue.PortRangeString(),
+	}
+
+	protocolsSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       protocolsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetProtocols, protocolsAttribute, protocolsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetProtocols, protocolsAttribute, protocolsUCIOption),
+		Validators:        protocol.Validators,
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		destAttribute:                     destSchemaAttribute,
+		destIPAttribute:                   destIPSchemaAttribute,
+		destPortAttribute:                 destPortSchemaAttribute,
+		enabledAttribute:                  enabledSchemaAttribute,
+		familyAttribute:                   familySchemaAttribute,
+		icmpTypesAttribute:                icmpTypesSchemaAttribute,
+		limitAttribute:                    limitSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		nameAttribute:                     nameSchemaAttribute,
+		protocolsAttribute:                protocolsSchemaAttribute,
+		srcAttribute:                      srcSchemaAttribute,
+		srcIPAttribute:                    srcIPSchemaAttribute,
+		srcPortAttribute:                  srcPortSchemaAttribute,
+		targetAttribute:                   targetSchemaAttribute,
+	}
+
+	srcSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       srcAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrc, srcAttribute, srcUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrc, srcAttribute, srcUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	srcIPSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       srcIPAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrcIP, srcIPAttribute, srcIPUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrcIP, srcIPAttribute, srcIPUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	srcPortSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       srcPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrcPort, srcPortAttribute, srcPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrcPort, srcPortAttribute, srcPortUCIOption),
+		Validators:        portValidators,
+	}
+
+	targetSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(targetDefaultValue),
+		Description:       targetAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTarget, targetAttribute, targetUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTarget, targetAttribute, targetUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				targetAccept,
+				targetDrop,
+				targetMark,
+				targetNoTrack,
+				targetReject,
+			),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Dest         types.String `tfsdk:"dest"`
+	DestIP       types.String `tfsdk:"dest_ip"`
+	DestPort     types.String `tfsdk:"dest_port"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Family       types.String `tfsdk:"family"`
+	ICMPTypes    types.Set    `tfsdk:"icmp_type"`
+	Id           types.String `tfsdk:"id"`
+	Limit        types.String `tfsdk:"limit"`
+	Name         types.String `tfsdk:"name"`
+	Protocols    types.Set    `tfsdk:"proto"`
+	Src          types.String `tfsdk:"src"`
+	SrcIP        types.String `tfsdk:"src_ip"`
+	SrcPort      types.String `tfsdk:"src_port"`
+	Target       types.String `tfsdk:"target"`
+}
+
+func modelGetDest(m model) types.String      { return m.Dest }
+func modelGetDestIP(m model) types.String    { return m.DestIP }
+func modelGetDestPort(m model) types.String  { return m.DestPort }
+func modelGetEnabled(m model) types.Bool     { return m.Enabled }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetFamily(m model) types.String    { return m.Family }
+func modelGetICMPTypes(m model) types.Set    { return m.ICMPTypes }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetLimit(m model) types.String     { return m.Limit }
+func modelGetName(m model) types.String      { return m.Name }
+func modelGetProtocols(m model) types.Set    { return m.Protocols }
+func modelGetSrc(m model) types.String       { return m.Src }
+func modelGetSrcIP(m model) types.String     { return m.SrcIP }
+func modelGetSrcPort(m model) types.String   { return m.SrcPort }
+func modelGetTarget(m model) types.String    { return m.Target }
+
+func modelSetDest(m *model, value types.String)      { m.Dest = value }
+func modelSetDestIP(m *model, value types.String)    { m.DestIP = value }
+func modelSetDestPort(m *model, value types.String)  { m.DestPort = value }
+func modelSetEnabled(m *model, value types.Bool)     { m.Enabled = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetFamily(m *model, value types.String)    { m.Family = value }
+func modelSetICMPTypes(m *model, value types.Set)    { m.ICMPTypes = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetLimit(m *model, value types.String)     { m.Limit = value }
+func modelSetName(m *model, value types.String)      { m.Name = value }
+func modelSetProtocols(m *model, value types.Set)    { m.Protocols = value }
+func modelSetSrc(m *model, value types.String)       { m.Src = value }
+func modelSetSrcIP(m *model, value types.String)     { m.SrcIP = value }
+func modelSetSrcPort(m *model, value types.String)   { m.SrcPort = value }
+func modelSetTarget(m *model, value types.String)    { m.Target = value }
@@ -0,0 +1,350 @@
+//go:build acceptance.test
+
+package rule_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"dest_port": lucirpc.String("22"),
+		"name":      lucirpc.String("Allow-SSH"),
+		"proto":     lucirpc.ListString([]string{"tcp"}),
+		"src":       lucirpc.String("lan"),
+		"target":    lucirpc.String("ACCEPT"),
+	}
+	ok, err := client.CreateSection(ctx, "firewall", "rule", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_rule" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "dest_port", "22"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "name", "Allow-SSH"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "proto.#", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "proto.0", "tcp"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "src", "lan"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_rule.testing", "target", "ACCEPT"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	dest_port = "22"
+	id = "testing"
+	name = "Allow-SSH"
+	proto = [
+		"tcp",
+	]
+	src = "lan"
+	target = "ACCEPT"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "dest_port", "22"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "enabled", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "family", "any"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "name", "Allow-SSH"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "proto.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "proto.0", "tcp"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "src", "lan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "target", "ACCEPT"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_rule.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	family = "ipv6"
+	icmp_type = [
+		"echo-reply",
+		"echo-request",
+	]
+	id = "testing"
+	limit = "1000/second"
+	name = "Allow-ICMPv6-Input"
+	proto = [
+		"icmp",
+	]
+	src = "wan"
+	src_ip = "fe80::/10"
+	target = "ACCEPT"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_firewall_rule.testing", "dest_port"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "family", "ipv6"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "icmp_type.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "limit", "1000/second"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "name", "Allow-ICMPv6-Input"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "proto.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "proto.0", "icmp"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "src", "wan"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "src_ip", "fe80::/10"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceAnonymousAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createWithoutIdAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	dest_port = "546"
+	family = "ipv6"
+	name = "Allow-DHCPv6"
+	proto = [
+		"udp",
+	]
+	src = "wan"
+	target = "ACCEPT"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_firewall_rule.testing", "id", regexp.MustCompile("^rule_[[:xdigit:]]{8}$")),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "dest_port", "546"),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "name", "Allow-DHCPv6"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_rule.testing",
+	}
+	importAnonymousSection := resource.TestStep{
+		// The default firewall configuration starts with the anonymous "Allow-DHCP-Renew" rule.
+		ImportState: true,
+		ImportStateCheck: func(states []*terraform.InstanceState) error {
+			if len(states) != 1 {
+				return fmt.Errorf("expected 1 state, got %d", len(states))
+			}
+
+			// Importing doesn't change anything on the device,
+			// so the anonymous section keeps its UCI-assigned name until it's applied.
+			id := states[0].Attributes["id"]
+			if !regexp.MustCompile("^cfg[[:xdigit:]]{6}$").MatchString(id) {
+				return fmt.Errorf("expected anonymous section to keep its name, got %q", id)
+			}
+
+			name := states[0].Attributes["name"]
+			if name != "Allow-DHCP-Renew" {
+				return fmt.Errorf("expected name to be %q, got %q", "Allow-DHCP-Renew", name)
+			}
+
+			return nil
+		},
+		ImportStateId: "@rule[0]",
+		ResourceName:  "openwrt_firewall_rule.testing",
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createWithoutIdAndReadResource,
+		importValidation,
+		importAnonymousSection,
+	)
+}
+
+func TestResourceImportAnonymousAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	config := fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	dest_port = "68"
+	family = "ipv4"
+	name = "Allow-DHCP-Renew"
+	proto = [
+		"udp",
+	]
+	src = "wan"
+	target = "ACCEPT"
+}
+`,
+		providerBlock,
+	)
+
+	importAnonymousSection := resource.TestStep{
+		// The default firewall configuration starts with the anonymous "Allow-DHCP-Renew" rule.
+		Config:             config,
+		ImportState:        true,
+		ImportStateId:      "@rule[0]",
+		ImportStatePersist: true,
+		ResourceName:       "openwrt_firewall_rule.testing",
+	}
+	renameAnonymousSection := resource.TestStep{
+		Config: config,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_firewall_rule.testing", "id", regexp.MustCompile("^rule_[[:xdigit:]]{8}$")),
+			resource.TestCheckResourceAttr("openwrt_firewall_rule.testing", "name", "Allow-DHCP-Renew"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		importAnonymousSection,
+		renameAnonymousSection,
+	)
+}
+
+func TestResourceInvalidPortAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	outOfRange := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	dest_port = "65536"
+	id = "testing"
+	src = "lan"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+	reversedRange := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	dest_port = "100-50"
+	id = "testing"
+	src = "lan"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		outOfRange,
+		reversedRange,
+	)
+}
+
+func TestResourceInvalidProtocolAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_rule" "testing" {
+	id = "testing"
+	proto = [
+		"tcp6",
+	]
+	src = "lan"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,233 @@
+package zone
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	familyAttribute            = "family"
+	familyAttributeDescription = `The protocol family this zone applies to. Must be one of: "any", "ipv4", "ipv6". Defaults to "any".`
+	familyAny                  = "any"
+	familyDefaultValue         = familyAny
+	familyIPv4                 = "ipv4"
+	familyIPv6                 = "ipv6"
+	familyUCIOption            = "family"
+
+	forwardAttribute            = "forward"
+	forwardAttributeDescription = `Policy for traffic forwarded between networks in this zone. Must be one of: "ACCEPT", "DROP", "REJECT". If unset, the policy from the firewall defaults is used.`
+	forwardUCIOption            = "forward"
+
+	inputAttribute            = "input"
+	inputAttributeDescription = `Policy for incoming traffic. Must be one of: "ACCEPT", "DROP", "REJECT". If unset, the policy from the firewall defaults is used.`
+	inputUCIOption            = "input"
+
+	logAttribute            = "log"
+	logAttributeDescription = "Create log rules for rejected and dropped traffic in this zone. Defaults to `false`."
+	logDefaultValue         = false
+	logUCIOption            = "log"
+
+	masqueradingAttribute            = "masq"
+	masqueradingAttributeDescription = "Enable masquerading (NAT) of outgoing traffic. Typically enabled on the wan zone. Defaults to `false`."
+	masqueradingDefaultValue         = false
+	masqueradingUCIOption            = "masq"
+
+	mssClampingAttribute            = "mtu_fix"
+	mssClampingAttributeDescription = "Enable MSS clamping for outgoing traffic. Defaults to `false`."
+	mssClampingDefaultValue         = false
+	mssClampingUCIOption            = "mtu_fix"
+
+	nameAttribute            = "name"
+	nameAttributeDescription = "Unique name of the zone. This is how other firewall sections (e.g. forwardings, rules) refer to this zone. At most 11 characters."
+	nameUCIOption            = "name"
+
+	networksAttribute            = "network"
+	networksAttributeDescription = "Networks attached to this zone. These names are what the interfaces are known as in UCI, or the `id` field in Terraform."
+	networksUCIOption            = "network"
+
+	outputAttribute            = "output"
+	outputAttributeDescription = `Policy for outgoing traffic. Must be one of: "ACCEPT", "DROP", "REJECT". If unset, the policy from the firewall defaults is used.`
+	outputUCIOption            = "output"
+
+	policyAccept = "ACCEPT"
+	policyDrop   = "DROP"
+	policyReject = "REJECT"
+
+	schemaDescription = "A group of networks with the same firewall policy."
+	schemaVersion     = 0
+
+	uciConfig = "firewall"
+	uciType   = "zone"
+)
+
+var (
+	familySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(familyDefaultValue),
+		Description:       familyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetFamily, familyAttribute, familyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetFamily, familyAttribute, familyUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				familyAny,
+				familyIPv4,
+				familyIPv6,
+			),
+		},
+	}
+
+	forwardSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       forwardAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetForward, forwardAttribute, forwardUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetForward, forwardAttribute, forwardUCIOption),
+		Validators:        policyValidators,
+	}
+
+	inputSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       inputAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetInput, inputAttribute, inputUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetInput, inputAttribute, inputUCIOption),
+		Validators:        policyValidators,
+	}
+
+	logSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(logDefaultValue),
+		Description:       logAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetLog, logAttribute, logUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetLog, logAttribute, logUCIOption),
+	}
+
+	masqueradingSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(masqueradingDefaultValue),
+		Description:       masqueradingAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetMasquerading, masqueradingAttribute, masqueradingUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetMasquerading, masqueradingAttribute, masqueradingUCIOption),
+	}
+
+	mssClampingSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(mssClampingDefaultValue),
+		Description:       mssClampingAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetMSSClamping, mssClampingAttribute, mssClampingUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetMSSClamping, mssClampingAttribute, mssClampingUCIOption),
+	}
+
+	nameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetName, nameAttribute, nameUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetName, nameAttribute, nameUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 11),
+		},
+	}
+
+	networksSchemaAttribute = lucirpcglue.SetStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       networksAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionSetString(modelSetNetworks, networksAttribute, networksUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionSetString(modelGetNetworks, networksAttribute, networksUCIOption),
+	}
+
+	outputSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       outputAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetOutput, outputAttribute, outputUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetOutput, outputAttribute, outputUCIOption),
+		Validators:        policyValidators,
+	}
+
+	policyValidators = []validator.String{
+		stringvalidator.OneOf(
+			policyAccept,
+			policyDrop,
+			policyReject,
+		),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		familyAttribute:                   familySchemaAttribute,
+		forwardAttribute:                  forwardSchemaAttribute,
+		inputAttribute:                    inputSchemaAttribute,
+		logAttribute:                      logSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		masqueradingAttribute:             masqueradingSchemaAttribute,
+		mssClampingAttribute:              mssClampingSchemaAttribute,
+		nameAttribute:                     nameSchemaAttribute,
+		networksAttribute:                 networksSchemaAttribute,
+		outputAttribute:                   outputSchemaAttribute,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Family       types.String `tfsdk:"family"`
+	Forward      types.String `tfsdk:"forward"`
+	Id           types.String `tfsdk:"id"`
+	Input        types.String `tfsdk:"input"`
+	Log          types.Bool   `tfsdk:"log"`
+	Masquerading types.Bool   `tfsdk:"masq"`
+	MSSClamping  types.Bool   `tfsdk:"mtu_fix"`
+	Name         types.String `tfsdk:"name"`
+	Networks     types.Set    `tfsdk:"network"`
+	Output       types.String `tfsdk:"output"`
+}
+
+func modelGetExtraOptions(m model) types.Map  { return m.ExtraOptions }
+func modelGetFamily(m model) types.String     { return m.Family }
+func modelGetForward(m model) types.String    { return m.Forward }
+func modelGetId(m model) types.String         { return m.Id }
+func modelGetInput(m model) types.String      { return m.Input }
+func modelGetLog(m model) types.Bool          { return m.Log }
+func modelGetMasquerading(m model) types.Bool { return m.Masquerading }
+func modelGetMSSClamping(m model) types.Bool  { return m.MSSClamping }
+func modelGetName(m model) types.String       { return m.Name }
+func modelGetNetworks(m model) types.Set      { return m.Networks }
+func modelGetOutput(m model) types.String     { return m.Output }
+
+func modelSetExtraOptions(m *model, value types.Map)  { m.ExtraOptions = value }
+func modelSetFamily(m *model, value types.String)     { m.Family = value }
+func modelSetForward(m *model, value types.String)    { m.Forward = value }
+func modelSetId(m *model, value types.String)         { m.Id = value }
+func modelSetInput(m *model, value types.String)      { m.Input = value }
+func modelSetLog(m *model, value types.Bool)          { m.Log = value }
+func modelSetMasquerading(m *model, value types.Bool) { m.Masquerading = value }
+func modelSetMSSClamping(m *model, value types.Bool)  { m.MSSClamping = value }
+func modelSetName(m *model, value types.String)       { m.Name = value }
+func modelSetNetworks(m *model, value types.Set)      { m.Networks = value }
+func modelSetOutput(m *model, value types.String)     { m.Output = value }
@@ -0,0 +1,182 @@
+//go:build acceptance.test
+
+package zone_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"forward": lucirpc.String("REJECT"),
+		"input":   lucirpc.String("ACCEPT"),
+		"masq":    lucirpc.Boolean(true),
+		"name":    lucirpc.String("testing"),
+		"network": lucirpc.ListString([]string{"testing"}),
+		"output":  lucirpc.String("ACCEPT"),
+	}
+	ok, err := client.CreateSection(ctx, "firewall", "zone", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_firewall_zone" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "forward", "REJECT"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "input", "ACCEPT"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "masq", "true"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "name", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "network.#", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "network.0", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_firewall_zone.testing", "output", "ACCEPT"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_zone" "testing" {
+	id = "testing"
+	input = "ACCEPT"
+	name = "testing"
+	network = [
+		"testing",
+	]
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "family", "any"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "input", "ACCEPT"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "log", "false"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "masq", "false"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "mtu_fix", "false"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "name", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "network.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "network.0", "testing"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_firewall_zone.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_zone" "testing" {
+	family = "ipv4"
+	forward = "DROP"
+	id = "testing"
+	input = "REJECT"
+	log = true
+	masq = true
+	mtu_fix = true
+	name = "testing"
+	network = [
+		"testing",
+		"testing2",
+	]
+	output = "ACCEPT"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "family", "ipv4"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "forward", "DROP"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "input", "REJECT"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "log", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "masq", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "mtu_fix", "true"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "name", "testing"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "network.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_firewall_zone.testing", "output", "ACCEPT"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceInvalidPolicyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_firewall_zone" "testing" {
+	id = "testing"
+	input = "accept"
+	name = "testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -57,6 +57,24 @@ func SetFieldListString(
 	return ctx
 }
 
+// SetFieldMap sets a map field on the logger in the [context.Context].
+func SetFieldMap(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	key string,
+	value interface{ Elements() map[string]attr.Value },
+) context.Context {
+	values := map[string]string{}
+	elements := value.Elements()
+	for elementKey, element := range elements {
+		values[elementKey] = element.String()
+	}
+
+	ctx = tflog.SetField(ctx, fmt.Sprintf("%s_%s_%s", fullTypeName, terraformType, key), values)
+	return ctx
+}
+
 // SetFieldSetString sets a set of strings field on the logger in the [context.Context].
 func SetFieldSetString(
 	ctx context.Context,
@@ -3,6 +3,7 @@ package lucirpcglue
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
@@ -11,6 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,8 +33,11 @@ const (
 	Optional
 	Required
 
-	idAttributeDescription = "Name of the section. This name is only used when interacting with UCI directly."
-	idUCISection           = ".name"
+	anonymousUCISection             = ".anonymous"
+	idAttributeDescription          = "Name of the section. This name is only used when interacting with UCI directly."
+	idOptionalAttributeDescription  = "Name of the section. This name is only used when interacting with UCI directly. If unset, a name is generated. Anonymous sections are given a generated name on the first apply after they are imported, since UCI changes the name of anonymous sections whenever they change."
+	idSingletonAttributeDescription = "Name of the section. This name is only used when interacting with UCI directly. If unset, the first section of this type is used. An anonymous section is given a generated name when it is adopted, since UCI changes the name of anonymous sections whenever they change."
+	idUCISection                    = ".name"
 
 	IdAttribute = "id"
 )
@@ -87,6 +99,46 @@ func (v anyValidatorBool) ValidateBool(ctx context.Context, req validator.BoolRe
 	}
 }
 
+// PortRangeString returns a validator which ensures that any configured attribute value is a port or port range (e.g. "22", "1024-65535", "1024:65535").
+// Ports must be between 1 and 65535, and a range must not end before it starts.
+func PortRangeString() validator.String {
+	return portRangeString{}
+}
+
+type portRangeString struct{}
+
+func (v portRangeString) Description(ctx context.Context) string {
+	return `must be a port or port range between 1 and 65535 (e.g. "22", "1024-65535")`
+}
+
+func (v portRangeString) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portRangeString) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	start, end, found := strings.Cut(value, "-")
+	if !found {
+		start, end, found = strings.Cut(value, ":")
+	}
+
+	if !found {
+		end = start
+	}
+
+	startPort, startOk := parsePort(start)
+	endPort, endOk := parsePort(end)
+	if !startOk || !endOk || startPort > endPort {
+		resp.Diagnostics.Append(
+			validatordiag.InvalidAttributeValueMatchDiagnostic(req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
 type AttributeExistence int
 
 func (e AttributeExistence) ToComputed() bool {
@@ -103,9 +155,11 @@ func (e AttributeExistence) ToRequired() bool {
 
 type BoolSchemaAttribute[Model any, Request any, Response any] struct {
 	DataSourceExistence AttributeExistence
+	Default             defaults.Bool
 	DeprecationMessage  string
 	Description         string
 	MarkdownDescription string
+	PlanModifiers       []planmodifier.Bool
 	ReadResponse        func(context.Context, string, string, Response, Model) (context.Context, Model, diag.Diagnostics)
 	ResourceExistence   AttributeExistence
 	Sensitive           bool
@@ -142,11 +196,13 @@ func (a BoolSchemaAttribute[Model, Request, Response]) ToDataSource() datasource
 
 func (a BoolSchemaAttribute[Model, Request, Response]) ToResource() resourceschema.Attribute {
 	return resourceschema.BoolAttribute{
-		Computed:            a.ResourceExistence.ToComputed(),
+		Computed:            a.ResourceExistence.ToComputed() || a.Default != nil,
+		Default:             a.Default,
 		DeprecationMessage:  a.DeprecationMessage,
 		Description:         a.Description,
 		MarkdownDescription: a.MarkdownDescription,
 		Optional:            a.ResourceExistence.ToOptional(),
+		PlanModifiers:       resourcePlanModifiers(a.ResourceExistence, a.Default != nil, boolplanmodifier.UseStateForUnknown(), a.PlanModifiers),
 		Required:            a.ResourceExistence.ToRequired(),
 		Sensitive:           a.Sensitive,
 		Validators:          a.Validators,
@@ -166,13 +222,66 @@ func (a BoolSchemaAttribute[Model, Request, Response]) Upsert(
 	return a.UpsertRequest(ctx, fullTypeName, request, model)
 }
 
+// IdSchemaAttribute constructs the `id` attribute of a section.
+// Changing the `id` replaces the section.
 func IdSchemaAttribute[Model any](
 	get func(Model) types.String,
 	set func(*Model, types.String),
+) SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
+	return idSchemaAttribute(
+		get,
+		set,
+		idAttributeDescription,
+		[]planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Required,
+		Required,
+	)
+}
+
+// OptionalIdSchemaAttribute constructs the `id` attribute of a section that is commonly anonymous.
+// If the `id` is not set, a name is generated when the section is created.
+// Changing the `id` renames the section in place.
+func OptionalIdSchemaAttribute[Model any](
+	get func(Model) types.String,
+	set func(*Model, types.String),
+) SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
+	return idSchemaAttribute(get, set, idOptionalAttributeDescription, nil, Required, NoValidation)
+}
+
+// RenamableIdSchemaAttribute constructs the `id` attribute of a section.
+// Changing the `id` renames the section in place.
+// This should only be used for sections that no other section refers to by name.
+func RenamableIdSchemaAttribute[Model any](
+	get func(Model) types.String,
+	set func(*Model, types.String),
+) SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
+	return idSchemaAttribute(get, set, idAttributeDescription, nil, Required, Required)
+}
+
+// SingletonIdSchemaAttribute constructs the `id` attribute of a section that there should only be one of.
+// If the `id` is not set, the first section of the type is used.
+// This should be used with [NewSingletonDataSource] and [NewSingletonResource].
+func SingletonIdSchemaAttribute[Model any](
+	get func(Model) types.String,
+	set func(*Model, types.String),
+) SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
+	return idSchemaAttribute(get, set, idSingletonAttributeDescription, nil, NoValidation, NoValidation)
+}
+
+func idSchemaAttribute[Model any](
+	get func(Model) types.String,
+	set func(*Model, types.String),
+	description string,
+	planModifiers []planmodifier.String,
+	dataSourceExistence AttributeExistence,
+	resourceExistence AttributeExistence,
 ) SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
 	return StringSchemaAttribute[Model, lucirpc.Options, lucirpc.Options]{
-		DataSourceExistence: Required,
-		Description:         idAttributeDescription,
+		DataSourceExistence: dataSourceExistence,
+		Description:         description,
+		PlanModifiers:       planModifiers,
 		ReadResponse: func(
 			ctx context.Context,
 			fullTypeName string,
@@ -184,7 +293,7 @@ func IdSchemaAttribute[Model any](
 			set(&model, value)
 			return ctx, model, diagnostics
 		},
-		ResourceExistence: Required,
+		ResourceExistence: resourceExistence,
 		UpsertRequest: func(
 			ctx context.Context,
 			fullTypeName string,
@@ -200,9 +309,11 @@ func IdSchemaAttribute[Model any](
 
 type Int64SchemaAttribute[Model any, Request any, Response any] struct {
 	DataSourceExistence AttributeExistence
+	Default             defaults.Int64
 	DeprecationMessage  string
 	Description         string
 	MarkdownDescription string
+	PlanModifiers       []planmodifier.Int64
 	ReadResponse        func(context.Context, string, string, Response, Model) (context.Context, Model, diag.Diagnostics)
 	ResourceExistence   AttributeExistence
 	Sensitive           bool
@@ -239,11 +350,13 @@ func (a Int64SchemaAttribute[Model, Request, Response]) ToDataSource() datasourc
 
 func (a Int64SchemaAttribute[Model, Request, Response]) ToResource() resourceschema.Attribute {
 	return resourceschema.Int64Attribute{
-		Computed:            a.ResourceExistence.ToComputed(),
+		Computed:            a.ResourceExistence.ToComputed() || a.Default != nil,
+		Default:             a.Default,
 		DeprecationMessage:  a.DeprecationMessage,
 		Description:         a.Description,
 		MarkdownDescription: a.MarkdownDescription,
 		Optional:            a.ResourceExistence.ToOptional(),
+		PlanModifiers:       resourcePlanModifiers(a.ResourceExistence, a.Default != nil, int64planmodifier.UseStateForUnknown(), a.PlanModifiers),
 		Required:            a.ResourceExistence.ToRequired(),
 		Sensitive:           a.Sensitive,
 		Validators:          a.Validators,
@@ -265,9 +378,11 @@ func (a Int64SchemaAttribute[Model, Request, Response]) Upsert(
 
 type ListStringSchemaAttribute[Model any, Request any, Response any] struct {
 	DataSourceExistence AttributeExistence
+	Default             defaults.List
 	DeprecationMessage  string
 	Description         string
 	MarkdownDescription string
+	PlanModifiers       []planmodifier.List
 	ReadResponse        func(context.Context, string, string, Response, Model) (context.Context, Model, diag.Diagnostics)
 	ResourceExistence   AttributeExistence
 	Sensitive           bool
@@ -305,12 +420,14 @@ func (a ListStringSchemaAttribute[Model, Request, Response]) ToDataSource() data
 
 func (a ListStringSchemaAttribute[Model, Request, Response]) ToResource() resourceschema.Attribute {
 	return resourceschema.ListAttribute{
-		Computed:            a.ResourceExistence.ToComputed(),
+		Computed:            a.ResourceExistence.ToComputed() || a.Default != nil,
+		Default:             a.Default,
 		DeprecationMessage:  a.DeprecationMessage,
 		Description:         a.Description,
 		ElementType:         types.StringType,
 		MarkdownDescription: a.MarkdownDescription,
 		Optional:            a.ResourceExistence.ToOptional(),
+		PlanModifiers:       resourcePlanModifiers(a.ResourceExistence, a.Default != nil, listplanmodifier.UseStateForUnknown(), a.PlanModifiers),
 		Required:            a.ResourceExistence.ToRequired(),
 		Sensitive:           a.Sensitive,
 		Validators:          a.Validators,
@@ -330,6 +447,77 @@ func (a ListStringSchemaAttribute[Model, Request, Response]) Upsert(
 	return a.UpsertRequest(ctx, fullTypeName, request, model)
 }
 
+type MapStringSchemaAttribute[Model any, Request any, Response any] struct {
+	DataSourceExistence AttributeExistence
+	Default             defaults.Map
+	DeprecationMessage  string
+	Description         string
+	MarkdownDescription string
+	PlanModifiers       []planmodifier.Map
+	ReadResponse        func(context.Context, string, string, Response, Model) (context.Context, Model, diag.Diagnostics)
+	ResourceExistence   AttributeExistence
+	Sensitive           bool
+	UpsertRequest       func(context.Context, string, Request, Model) (context.Context, Request, diag.Diagnostics)
+	Validators          []validator.Map
+}
+
+func (a MapStringSchemaAttribute[Model, Request, Response]) Read(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	response Response,
+	model Model,
+) (context.Context, Model, diag.Diagnostics) {
+	if a.ReadResponse == nil {
+		return ctx, model, diag.Diagnostics{}
+	}
+
+	return a.ReadResponse(ctx, fullTypeName, terraformType, response, model)
+}
+
+func (a MapStringSchemaAttribute[Model, Request, Response]) ToDataSource() datasourceschema.Attribute {
+	return datasourceschema.MapAttribute{
+		Computed:            a.DataSourceExistence.ToComputed(),
+		DeprecationMessage:  a.DeprecationMessage,
+		Description:         a.Description,
+		ElementType:         types.StringType,
+		MarkdownDescription: a.MarkdownDescription,
+		Optional:            a.DataSourceExistence.ToOptional(),
+		Required:            a.DataSourceExistence.ToRequired(),
+		Sensitive:           a.Sensitive,
+		Validators:          a.Validators,
+	}
+}
+
+func (a MapStringSchemaAttribute[Model, Request, Response]) ToResource() resourceschema.Attribute {
+	return resourceschema.MapAttribute{
+		Computed:            a.ResourceExistence.ToComputed() || a.Default != nil,
+		Default:             a.Default,
+		DeprecationMessage:  a.DeprecationMessage,
+		Description:         a.Description,
+		ElementType:         types.StringType,
+		MarkdownDescription: a.MarkdownDescription,
+		Optional:            a.ResourceExistence.ToOptional(),
+		PlanModifiers:       resourcePlanModifiers(a.ResourceExistence, a.Default != nil, mapplanmodifier.UseStateForUnknown(), a.PlanModifiers),
+		Required:            a.ResourceExistence.ToRequired(),
+		Sensitive:           a.Sensitive,
+		Validators:          a.Validators,
+	}
+}
+
+func (a MapStringSchemaAttribute[Model, Request, Response]) Upsert(
+	ctx context.Context,
+	fullTypeName string,
+	request Request,
+	model Model,
+) (context.Context, Request, diag.Diagnostics) {
+	if a.UpsertRequest == nil {
+		return ctx, request, diag.Diagnostics{}
+	}
+
+	return a.UpsertRequest(ctx, fullTypeName, request, model)
+}
+
 func ReadResponseOptionBool[Model any](
 	set func(*Model, types.Bool),
 	attribute string,
@@ -462,9 +650,11 @@ type SchemaAttribute[Model any, Request any, Response any] interface {
 
 type SetStringSchemaAttribute[Model any, Request any, Response any] struct {
 	DataSourceExistence AttributeExistence
+	Default             defaults.Set
 	DeprecationMessage  string
 	Description         string
 	MarkdownDescription string
+	PlanModifiers       []planmodifier.Set
 	ReadResponse        func(context.Context, string, string, Response, Model) (context.Context, Model, diag.Diagnostics)
 	ResourceExistence   AttributeExistence
 	Sensitive           bool
@@ -502,12 +692,14 @@ func (a SetStringSchemaAttribute[Model, Request, Response]) ToDataSource() datas
 
 func (a SetStringSchemaAttribute[Model, Request, Response]) ToResource() resourceschema.Attribute {
 	return resourceschema.SetAttribute{
-		Computed:            a.ResourceExistence.ToComputed(),
+		Computed:            a.ResourceExistence.ToComputed() || a.Default != nil,
+		Default:             a.Default,
 		DeprecationMessage:  a.DeprecationMessage,
 		Description:         a.Description,
 		ElementType:         types.StringType,
 		MarkdownDescription: a.MarkdownDescription,
 		Optional:            a.ResourceExistence.ToOptional(),
+		PlanModifiers:       resourcePlanModifiers(a.ResourceExistence, a.Default != nil, setplanmodifier.UseStateForUnknown(), a.PlanModifiers),
 		Required:            a.ResourceExistence.ToRequired(),
 		Sensitive:           a.Sensitive,
 		Validators:          a.Validators,
@@ -529,9 +721,11 @@ func (a SetStringSchemaAttribute[Model, Request, Response]) Upsert(
 
 type StringSchemaAttribute[Model any, Request any, Response any] struct {
 	DataSourceExistence AttributeExistence
+	Default             defaults.String
 	DeprecationMessage  string
 	Description         string
 	MarkdownDescription string
+	PlanModifiers       []planmodifier.String
 	ReadResponse        func(context.Context, string, string, Response, Model) (context.Context, Model, diag.Diagnostics)
 	ResourceExistence   AttributeExistence
 	Sensitive           bool
@@ -568,11 +762,13 @@ func (a StringSchemaAttribute[Model, Request, Response]) ToDataSource() datasour
 
 func (a StringSchemaAttribute[Model, Request, Response]) ToResource() resourceschema.Attribute {
 	return resourceschema.StringAttribute{
-		Computed:            a.ResourceExistence.ToComputed(),
+		Computed:            a.ResourceExistence.ToComputed() || a.Default != nil,
+		Default:             a.Default,
 		DeprecationMessage:  a.DeprecationMessage,
 		Description:         a.Description,
 		MarkdownDescription: a.MarkdownDescription,
 		Optional:            a.ResourceExistence.ToOptional(),
+		PlanModifiers:       resourcePlanModifiers(a.ResourceExistence, a.Default != nil, stringplanmodifier.UseStateForUnknown(), a.PlanModifiers),
 		Required:            a.ResourceExistence.ToRequired(),
 		Sensitive:           a.Sensitive,
 		Validators:          a.Validators,
@@ -712,6 +908,21 @@ func UpsertRequestOptionString[Model any](
 	}
 }
 
+func parsePort(
+	value string,
+) (int, bool) {
+	if strings.HasPrefix(value, "0") {
+		return 0, false
+	}
+
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || port < 1 {
+		return 0, false
+	}
+
+	return int(port), true
+}
+
 type attributeHasValue interface {
 	IsNull() bool
 	IsUnknown() bool
@@ -1039,6 +1250,22 @@ func requiresAttributeEqual[Value any](
 	}
 }
 
+// resourcePlanModifiers prepends a "use state for unknown" plan modifier to computed attributes without a default.
+// Since we never unset options,
+// the value on the device stays the same unless the configuration changes it.
+func resourcePlanModifiers[PlanModifier any](
+	existence AttributeExistence,
+	hasDefault bool,
+	useStateForUnknown PlanModifier,
+	planModifiers []PlanModifier,
+) []PlanModifier {
+	if !existence.ToComputed() || hasDefault {
+		return planModifiers
+	}
+
+	return append([]PlanModifier{useStateForUnknown}, planModifiers...)
+}
+
 func serializeListString(
 	ctx context.Context,
 	attribute interface{ Elements() []attr.Value },
@@ -0,0 +1,71 @@
+package lucirpcglue_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+	"gotest.tools/v3/assert"
+)
+
+func TestPortRangeString(t *testing.T) {
+	valid := []string{
+		"1",
+		"22",
+		"65535",
+		"1024-65535",
+		"1024:65535",
+		"80-80",
+	}
+	for _, value := range valid {
+		t.Run("accepts "+value, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			req := validator.StringRequest{
+				ConfigValue: types.StringValue(value),
+				Path:        path.Root("port"),
+			}
+			res := validator.StringResponse{}
+
+			// When
+			lucirpcglue.PortRangeString().ValidateString(ctx, req, &res)
+
+			// Then
+			assert.Assert(t, !res.Diagnostics.HasError(), res.Diagnostics)
+		})
+	}
+
+	invalid := []string{
+		"",
+		"0",
+		"022",
+		"+22",
+		"65536",
+		"100-50",
+		"100:50",
+		"1024-",
+		"-1024",
+		"1-2-3",
+		"http",
+	}
+	for _, value := range invalid {
+		t.Run("rejects "+value, func(t *testing.T) {
+			// Given
+			ctx := context.Background()
+			req := validator.StringRequest{
+				ConfigValue: types.StringValue(value),
+				Path:        path.Root("port"),
+			}
+			res := validator.StringResponse{}
+
+			// When
+			lucirpcglue.PortRangeString().ValidateString(ctx, req, &res)
+
+			// Then
+			assert.Assert(t, res.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,425 @@
+package lucirpcglue
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ frameworkresource.ConfigValidator = attributeOneOf[any]{}
+	_ frameworkresource.ConfigValidator = distinct{}
+	_ frameworkresource.ConfigValidator = noneOf{}
+	_ frameworkresource.ConfigValidator = whenAttribute[any]{}
+	_ frameworkresource.ConfigValidator = whenAttributeUnset{}
+)
+
+// AllOrNone returns a resource-level validator which ensures that either all of the given attributes are configured,
+// or none of them are.
+func AllOrNone(expressions ...path.Expression) frameworkresource.ConfigValidator {
+	return resourcevalidator.RequiredTogether(expressions...)
+}
+
+// AtLeastOneOf returns a resource-level validator which ensures that at least one of the given attributes is configured.
+// With a single attribute, it ensures that attribute is configured.
+func AtLeastOneOf(expressions ...path.Expression) frameworkresource.ConfigValidator {
+	return resourcevalidator.AtLeastOneOf(expressions...)
+}
+
+// AttributeOneOfString returns a resource-level validator which ensures that the given attribute,
+// if it is configured, is set to one of the given values.
+// It is mostly useful as part of a conditional group (e.g. `WhenAttributeEqualString`),
+// when the allowed values of an attribute depend on another attribute.
+func AttributeOneOfString(
+	expression path.Expression,
+	values ...string,
+) frameworkresource.ConfigValidator {
+	return attributeOneOf[string]{
+		attrType:   types.StringType,
+		expression: expression,
+		values:     values,
+	}
+}
+
+// Distinct returns a resource-level validator which ensures that the configured values of the given attributes are all different.
+func Distinct(expressions ...path.Expression) frameworkresource.ConfigValidator {
+	return distinct{
+		expressions: expressions,
+	}
+}
+
+// ExactlyOneOf returns a resource-level validator which ensures that exactly one of the given attributes is configured.
+func ExactlyOneOf(expressions ...path.Expression) frameworkresource.ConfigValidator {
+	return resourcevalidator.ExactlyOneOf(expressions...)
+}
+
+// NoneOf returns a resource-level validator which ensures that none of the given attributes are configured.
+// It is mostly useful as part of a conditional group (e.g. `WhenAttributeEqualString`).
+func NoneOf(expressions ...path.Expression) frameworkresource.ConfigValidator {
+	return noneOf{
+		expressions: expressions,
+	}
+}
+
+// WhenAttributeEqualBool returns a resource-level validator which applies the given validators
+// only when the attribute is configured to the expected value.
+func WhenAttributeEqualBool(
+	expression path.Expression,
+	expected bool,
+	validators ...frameworkresource.ConfigValidator,
+) frameworkresource.ConfigValidator {
+	return whenAttributeEqual(
+		types.BoolType,
+		expression,
+		expected,
+		validators,
+	)
+}
+
+// WhenAttributeEqualString returns a resource-level validator which applies the given validators
+// only when the attribute is configured to the expected value.
+func WhenAttributeEqualString(
+	expression path.Expression,
+	expected string,
+	validators ...frameworkresource.ConfigValidator,
+) frameworkresource.ConfigValidator {
+	return whenAttributeEqual(
+		types.StringType,
+		expression,
+		expected,
+		validators,
+	)
+}
+
+// WhenAttributeUnset returns a resource-level validator which applies the given validators
+// only when the attribute is not configured.
+// It is mostly useful for attributes with a default value,
+// alongside a validator for when the attribute is configured to that value.
+func WhenAttributeUnset(
+	expression path.Expression,
+	validators ...frameworkresource.ConfigValidator,
+) frameworkresource.ConfigValidator {
+	return whenAttributeUnset{
+		expression: expression,
+		validators: validators,
+	}
+}
+
+type attributeOneOf[Value any] struct {
+	attrType   attr.Type
+	expression path.Expression
+	values     []Value
+}
+
+func (v attributeOneOf[Value]) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v attributeOneOf[Value]) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Ensures that %q is configured with one of: %v", v.expression, v.values)
+}
+
+func (v attributeOneOf[Value]) ValidateResource(
+	ctx context.Context,
+	req frameworkresource.ValidateConfigRequest,
+	res *frameworkresource.ValidateConfigResponse,
+) {
+	matchedPaths, diagnostics := req.Config.PathMatches(ctx, v.expression)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	var expected []attr.Value
+	for _, value := range v.values {
+		var expectedValue attr.Value
+		diagnostics = tfsdk.ValueFrom(ctx, value, v.attrType, &expectedValue)
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+
+		expected = append(expected, expectedValue)
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var actual attr.Value
+		diagnostics = req.Config.GetAttribute(ctx, matchedPath, &actual)
+		res.Diagnostics.Append(diagnostics...)
+		if diagnostics.HasError() {
+			continue
+		}
+
+		if actual.IsNull() || actual.IsUnknown() {
+			continue
+		}
+
+		if !containsValue(expected, actual) {
+			res.Diagnostics.Append(
+				validatordiag.InvalidAttributeValueMatchDiagnostic(
+					matchedPath,
+					fmt.Sprintf("value must be one of: %v", v.values),
+					actual.String(),
+				),
+			)
+		}
+	}
+}
+
+type distinct struct {
+	expressions path.Expressions
+}
+
+func (v distinct) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v distinct) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Ensures that these attributes are all configured with different values: %s", v.expressions)
+}
+
+func (v distinct) ValidateResource(
+	ctx context.Context,
+	req frameworkresource.ValidateConfigRequest,
+	res *frameworkresource.ValidateConfigResponse,
+) {
+	seen := map[string]path.Path{}
+	for _, expression := range v.expressions {
+		matchedPaths, diagnostics := req.Config.PathMatches(ctx, expression)
+		res.Diagnostics.Append(diagnostics...)
+		if diagnostics.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var value attr.Value
+			diagnostics = req.Config.GetAttribute(ctx, matchedPath, &value)
+			res.Diagnostics.Append(diagnostics...)
+			if diagnostics.HasError() {
+				continue
+			}
+
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			other, ok := seen[value.String()]
+			if ok {
+				res.Diagnostics.Append(
+					validatordiag.InvalidAttributeCombinationDiagnostic(
+						matchedPath,
+						fmt.Sprintf("Attribute %q cannot have the same value as %q: %s", matchedPath, other, value),
+					),
+				)
+				continue
+			}
+
+			seen[value.String()] = matchedPath
+		}
+	}
+}
+
+func containsValue(
+	values []attr.Value,
+	value attr.Value,
+) bool {
+	for _, candidate := range values {
+		if candidate.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type noneOf struct {
+	expressions path.Expressions
+}
+
+func (v noneOf) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v noneOf) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Ensures that none of these attributes are configured: %s", v.expressions)
+}
+
+func (v noneOf) ValidateResource(
+	ctx context.Context,
+	req frameworkresource.ValidateConfigRequest,
+	res *frameworkresource.ValidateConfigResponse,
+) {
+	for _, expression := range v.expressions {
+		matchedPaths, diagnostics := req.Config.PathMatches(ctx, expression)
+		res.Diagnostics.Append(diagnostics...)
+		if diagnostics.HasError() {
+			continue
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var value attr.Value
+			diagnostics = req.Config.GetAttribute(ctx, matchedPath, &value)
+			res.Diagnostics.Append(diagnostics...)
+			if diagnostics.HasError() {
+				continue
+			}
+
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			res.Diagnostics.Append(
+				validatordiag.InvalidAttributeCombinationDiagnostic(
+					matchedPath,
+					fmt.Sprintf("Attribute %q cannot be specified", matchedPath),
+				),
+			)
+		}
+	}
+}
+
+type whenAttribute[Value any] struct {
+	attrType   attr.Type
+	expected   Value
+	expression path.Expression
+	validators []frameworkresource.ConfigValidator
+}
+
+func (v whenAttribute[Value]) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v whenAttribute[Value]) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("When %q is set to %v, ensures: %s", v.expression, v.expected, strings.Join(descriptions, " + "))
+}
+
+func (v whenAttribute[Value]) ValidateResource(
+	ctx context.Context,
+	req frameworkresource.ValidateConfigRequest,
+	res *frameworkresource.ValidateConfigResponse,
+) {
+	applies, diagnostics := v.applies(ctx, req.Config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() || !applies {
+		return
+	}
+
+	for _, subValidator := range v.validators {
+		subValidator.ValidateResource(ctx, req, res)
+	}
+}
+
+func (v whenAttribute[Value]) applies(
+	ctx context.Context,
+	config tfsdk.Config,
+) (applies bool, allDiagnostics diag.Diagnostics) {
+	matchedPaths, diagnostics := config.PathMatches(ctx, v.expression)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return
+	}
+
+	var expected attr.Value
+	diagnostics = tfsdk.ValueFrom(ctx, v.expected, v.attrType, &expected)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var actual attr.Value
+		diagnostics = config.GetAttribute(ctx, matchedPath, &actual)
+		allDiagnostics.Append(diagnostics...)
+		if allDiagnostics.HasError() {
+			return
+		}
+
+		if actual.IsUnknown() {
+			// Ignore this group until the value is known.
+			return false, allDiagnostics
+		}
+
+		if !actual.Equal(expected) {
+			return false, allDiagnostics
+		}
+	}
+
+	return len(matchedPaths) > 0, allDiagnostics
+}
+
+func whenAttributeEqual[Value any](
+	attrType attr.Type,
+	expression path.Expression,
+	expected Value,
+	validators []frameworkresource.ConfigValidator,
+) whenAttribute[Value] {
+	return whenAttribute[Value]{
+		attrType:   attrType,
+		expected:   expected,
+		expression: expression,
+		validators: validators,
+	}
+}
+
+type whenAttributeUnset struct {
+	expression path.Expression
+	validators []frameworkresource.ConfigValidator
+}
+
+func (v whenAttributeUnset) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v whenAttributeUnset) MarkdownDescription(ctx context.Context) string {
+	var descriptions []string
+
+	for _, subValidator := range v.validators {
+		descriptions = append(descriptions, subValidator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("When %q is not set, ensures: %s", v.expression, strings.Join(descriptions, " + "))
+}
+
+func (v whenAttributeUnset) ValidateResource(
+	ctx context.Context,
+	req frameworkresource.ValidateConfigRequest,
+	res *frameworkresource.ValidateConfigResponse,
+) {
+	matchedPaths, diagnostics := req.Config.PathMatches(ctx, v.expression)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	for _, matchedPath := range matchedPaths {
+		var value attr.Value
+		diagnostics = req.Config.GetAttribute(ctx, matchedPath, &value)
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+
+		if !value.IsNull() {
+			return
+		}
+	}
+
+	for _, subValidator := range v.validators {
+		subValidator.ValidateResource(ctx, req, res)
+	}
+}
@@ -26,7 +26,7 @@ func NewDataSource[Model any](
 ) datasource.DataSource {
 	return &dataSource[Model]{
 		getId:             getId,
-		schemaAttributes:  schemaAttributes,
+		schemaAttributes:  ownExtraOptions(schemaAttributes),
 		schemaDescription: schemaDescription,
 		terraformType:     DataSourceTerraformType,
 		uciConfig:         uciConfig,
@@ -34,12 +34,34 @@ func NewDataSource[Model any](
 	}
 }
 
+// NewSingletonDataSource constructs a data source for a type that there should only be one section of.
+// E.g. `firewall.defaults`.
+// If the `id` is not set, the first section of the type is read.
+func NewSingletonDataSource[Model any](
+	getId func(Model) types.String,
+	schemaAttributes map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options],
+	schemaDescription string,
+	uciConfig string,
+	uciType string,
+) datasource.DataSource {
+	return &dataSource[Model]{
+		getId:             getId,
+		schemaAttributes:  ownExtraOptions(schemaAttributes),
+		schemaDescription: schemaDescription,
+		singleton:         true,
+		terraformType:     DataSourceTerraformType,
+		uciConfig:         uciConfig,
+		uciType:           uciType,
+	}
+}
+
 type dataSource[Model any] struct {
 	client            lucirpc.Client
 	fullTypeName      string
 	getId             func(Model) types.String
 	schemaAttributes  map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options]
 	schemaDescription string
+	singleton         bool
 	terraformType     string
 	uciConfig         string
 	uciType           string
@@ -92,14 +114,21 @@ func (d *dataSource[Model]) Read(
 		return
 	}
 
+	id := d.getId(model).ValueString()
+	if d.singleton && d.getId(model).IsNull() {
+		tflog.Debug(ctx, "Reading the first section of the type")
+		id = firstSectionOfType(d.uciType)
+	}
+
 	ctx, model, diagnostics = ReadModel(
 		ctx,
 		d.fullTypeName,
 		d.terraformType,
 		d.client,
 		d.schemaAttributes,
+		model,
 		d.uciConfig,
-		d.getId(model).ValueString(),
+		id,
 	)
 	res.Diagnostics.Append(diagnostics...)
 	if res.Diagnostics.HasError() {
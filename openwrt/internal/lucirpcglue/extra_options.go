@@ -0,0 +1,281 @@
+package lucirpcglue
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/logger"
+)
+
+const (
+	ExtraOptionsAttribute            = "extra_options"
+	extraOptionsAttributeDescription = "Additional UCI options that do not have a dedicated attribute. Each option must set exactly one of `value` (for a UCI option) or `values` (for a UCI list). Options that have a dedicated attribute cannot be set here."
+
+	extraOptionsValueAttribute            = "value"
+	extraOptionsValueAttributeDescription = "The value of a UCI option."
+
+	extraOptionsValuesAttribute            = "values"
+	extraOptionsValuesAttributeDescription = "The values of a UCI list."
+)
+
+var (
+	extraOptionsElementType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			extraOptionsValueAttribute: types.StringType,
+			extraOptionsValuesAttribute: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+)
+
+// ExtraOptionsSchemaAttribute constructs the `extra_options` attribute of a section.
+// It passes through any UCI options that do not have a dedicated attribute.
+//
+// The options owned by the other attributes are filled in when the resource or data source is constructed.
+func ExtraOptionsSchemaAttribute[Model any](
+	get func(Model) types.Map,
+	set func(*Model, types.Map),
+) SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
+	return extraOptionsSchemaAttribute[Model]{
+		get: get,
+		set: set,
+	}
+}
+
+type extraOption struct {
+	Value  types.String `tfsdk:"value"`
+	Values types.List   `tfsdk:"values"`
+}
+
+type extraOptionsSchemaAttribute[Model any] struct {
+	get          func(Model) types.Map
+	ownedOptions []string
+	set          func(*Model, types.Map)
+}
+
+// Read parses the options that are not owned by any other attribute.
+//
+// A data source reads all such options.
+// A resource only reads the options it already manages,
+// so options set outside of Terraform do not show up as drift.
+func (a extraOptionsSchemaAttribute[Model]) Read(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	section lucirpc.Options,
+	model Model,
+) (context.Context, Model, diag.Diagnostics) {
+	allDiagnostics := diag.Diagnostics{}
+	var managed map[string]extraOption
+	if terraformType == ResourceTerraformType {
+		current := a.get(model)
+		if current.IsNull() || current.IsUnknown() {
+			a.set(&model, types.MapNull(extraOptionsElementType))
+			return ctx, model, allDiagnostics
+		}
+
+		diagnostics := current.ElementsAs(ctx, &managed, false)
+		allDiagnostics.Append(diagnostics...)
+		if allDiagnostics.HasError() {
+			return ctx, model, allDiagnostics
+		}
+	}
+
+	result := map[string]extraOption{}
+	for option, value := range section {
+		if strings.HasPrefix(option, ".") || a.isOwned(option) {
+			continue
+		}
+
+		if managed != nil {
+			if _, ok := managed[option]; !ok {
+				continue
+			}
+		}
+
+		attribute := path.Root(ExtraOptionsAttribute).AtMapKey(option)
+		values, err := value.AsListString()
+		if err == nil {
+			list, diagnostics := types.ListValueFrom(ctx, types.StringType, values)
+			allDiagnostics.Append(diagnostics...)
+			result[option] = extraOption{
+				Value:  types.StringNull(),
+				Values: list,
+			}
+			continue
+		}
+
+		stringValue, err := value.AsString()
+		if err != nil {
+			allDiagnostics.AddAttributeError(
+				attribute,
+				fmt.Sprintf("unable to parse option: %q", option),
+				err.Error(),
+			)
+			continue
+		}
+
+		result[option] = extraOption{
+			Value:  types.StringValue(stringValue),
+			Values: types.ListNull(types.StringType),
+		}
+	}
+
+	if allDiagnostics.HasError() {
+		return ctx, model, allDiagnostics
+	}
+
+	value, diagnostics := types.MapValueFrom(ctx, extraOptionsElementType, result)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, model, allDiagnostics
+	}
+
+	a.set(&model, value)
+	ctx = logger.SetFieldMap(ctx, fullTypeName, terraformType, ExtraOptionsAttribute, value)
+	return ctx, model, allDiagnostics
+}
+
+func (a extraOptionsSchemaAttribute[Model]) ToDataSource() datasourceschema.Attribute {
+	return datasourceschema.MapNestedAttribute{
+		Computed:    true,
+		Description: extraOptionsAttributeDescription,
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				extraOptionsValueAttribute: datasourceschema.StringAttribute{
+					Computed:    true,
+					Description: extraOptionsValueAttributeDescription,
+				},
+				extraOptionsValuesAttribute: datasourceschema.ListAttribute{
+					Computed:    true,
+					Description: extraOptionsValuesAttributeDescription,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func (a extraOptionsSchemaAttribute[Model]) ToResource() resourceschema.Attribute {
+	return resourceschema.MapNestedAttribute{
+		Description: extraOptionsAttributeDescription,
+		NestedObject: resourceschema.NestedAttributeObject{
+			Attributes: map[string]resourceschema.Attribute{
+				extraOptionsValueAttribute: resourceschema.StringAttribute{
+					Description: extraOptionsValueAttributeDescription,
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName(extraOptionsValuesAttribute),
+						),
+					},
+				},
+				extraOptionsValuesAttribute: resourceschema.ListAttribute{
+					Description: extraOptionsValuesAttributeDescription,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		},
+		Optional: true,
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[[:alnum:]_]+$"),
+					"must be a valid UCI option name",
+				),
+				stringvalidator.NoneOf(a.ownedOptions...),
+			),
+		},
+	}
+}
+
+func (a extraOptionsSchemaAttribute[Model]) Upsert(
+	ctx context.Context,
+	fullTypeName string,
+	options lucirpc.Options,
+	model Model,
+) (context.Context, lucirpc.Options, diag.Diagnostics) {
+	allDiagnostics := diag.Diagnostics{}
+	value := a.get(model)
+	if value.IsNull() || value.IsUnknown() {
+		return ctx, options, allDiagnostics
+	}
+
+	var extraOptions map[string]extraOption
+	diagnostics := value.ElementsAs(ctx, &extraOptions, false)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, options, allDiagnostics
+	}
+
+	for option, extra := range extraOptions {
+		if !extra.Value.IsNull() && !extra.Value.IsUnknown() {
+			options[option] = lucirpc.String(extra.Value.ValueString())
+			continue
+		}
+
+		if extra.Values.IsNull() || extra.Values.IsUnknown() {
+			continue
+		}
+
+		var values []string
+		diagnostics = extra.Values.ElementsAs(ctx, &values, false)
+		allDiagnostics.Append(diagnostics...)
+		if diagnostics.HasError() {
+			continue
+		}
+
+		options[option] = lucirpc.ListString(values)
+	}
+
+	ctx = logger.SetFieldMap(ctx, fullTypeName, ResourceTerraformType, ExtraOptionsAttribute, value)
+	return ctx, options, allDiagnostics
+}
+
+func (a extraOptionsSchemaAttribute[Model]) isOwned(option string) bool {
+	index := sort.SearchStrings(a.ownedOptions, option)
+	return index < len(a.ownedOptions) && a.ownedOptions[index] == option
+}
+
+// ownExtraOptions records the options owned by every other attribute on the `extra_options` attribute (if there is one).
+// Since attributes are named after the UCI option they manage,
+// the owned options are the names of the other attributes.
+func ownExtraOptions[Model any](
+	schemaAttributes map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options],
+) map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options] {
+	extraOptions, ok := schemaAttributes[ExtraOptionsAttribute].(extraOptionsSchemaAttribute[Model])
+	if !ok {
+		return schemaAttributes
+	}
+
+	ownedOptions := []string{}
+	result := map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options]{}
+	for name, attribute := range schemaAttributes {
+		result[name] = attribute
+		if name == ExtraOptionsAttribute || name == IdAttribute {
+			continue
+		}
+
+		ownedOptions = append(ownedOptions, name)
+	}
+
+	sort.Strings(ownedOptions)
+	extraOptions.ownedOptions = ownedOptions
+	result[ExtraOptionsAttribute] = extraOptions
+	return result
+}
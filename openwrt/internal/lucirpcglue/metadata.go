@@ -10,6 +10,31 @@ import (
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/logger"
 )
 
+// GetMetadataBool attempts to parse the given metadata key from the section as a bool.
+// Any diagnostic information found in the process (including errors) is returned.
+func GetMetadataBool(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	section lucirpc.Options,
+	key string,
+) (context.Context, types.Bool, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	result := types.BoolNull()
+	value, err := section.GetBoolean(key)
+	if err != nil {
+		diagnostics.AddError(
+			fmt.Sprintf("unable to parse metadata: %q", key),
+			err.Error(),
+		)
+		return ctx, result, diagnostics
+	}
+
+	result = types.BoolValue(value)
+	ctx = logger.SetFieldBool(ctx, fullTypeName, terraformType, key, result)
+	return ctx, result, diagnostics
+}
+
 // GetMetadataString attempts to parse the given metadata key from the section as a string.
 // Any diagnostic information found in the process (including errors) is returned.
 func GetMetadataString(
@@ -35,14 +35,12 @@ func ReadModel[Model any](
 	terraformType string,
 	client lucirpc.Client,
 	attributes map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options],
+	model Model,
 	uciConfig string,
 	uciSection string,
 ) (context.Context, Model, diag.Diagnostics) {
 	tflog.Info(ctx, fmt.Sprintf("Reading %s model", fullTypeName))
-	var (
-		allDiagnostics diag.Diagnostics
-		model          Model
-	)
+	var allDiagnostics diag.Diagnostics
 
 	section, diagnostics := GetSection(ctx, client, uciConfig, uciSection)
 	allDiagnostics.Append(diagnostics...)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,22 +16,89 @@ import (
 )
 
 var (
-	_ frameworkresource.Resource                = &resource[any]{}
-	_ frameworkresource.ResourceWithConfigure   = &resource[any]{}
-	_ frameworkresource.ResourceWithImportState = &resource[any]{}
+	_ frameworkresource.Resource                     = &resource[any]{}
+	_ frameworkresource.ResourceWithConfigValidators = &resource[any]{}
+	_ frameworkresource.ResourceWithConfigure        = &resource[any]{}
+	_ frameworkresource.ResourceWithImportState      = &resource[any]{}
+	_ frameworkresource.ResourceWithModifyPlan       = &resource[any]{}
+	_ frameworkresource.ResourceWithUpgradeState     = &resource[any]{}
 )
 
 func NewResource[Model any](
+	configValidators []frameworkresource.ConfigValidator,
 	getId func(Model) types.String,
 	schemaAttributes map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options],
 	schemaDescription string,
+	schemaVersion int64,
+	stateUpgraders map[int64]frameworkresource.StateUpgrader,
+	uciConfig string,
+	uciType string,
+) frameworkresource.Resource {
+	return NewResourceWithDynamicType(
+		configValidators,
+		getId,
+		func(Model) string { return uciType },
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		stateUpgraders,
+		uciConfig,
+		uciType,
+	)
+}
+
+// NewResourceWithDynamicType constructs a resource whose sections have a UCI type that depends on the model.
+// E.g. WireGuard peers have the type `wireguard_<interface>`.
+// The given `uciType` is only used to name the resource.
+func NewResourceWithDynamicType[Model any](
+	configValidators []frameworkresource.ConfigValidator,
+	getId func(Model) types.String,
+	getUCIType func(Model) string,
+	schemaAttributes map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options],
+	schemaDescription string,
+	schemaVersion int64,
+	stateUpgraders map[int64]frameworkresource.StateUpgrader,
 	uciConfig string,
 	uciType string,
 ) frameworkresource.Resource {
 	return &resource[Model]{
+		configValidators:  configValidators,
 		getId:             getId,
-		schemaAttributes:  schemaAttributes,
+		getUCIType:        getUCIType,
+		schemaAttributes:  ownExtraOptions(schemaAttributes),
 		schemaDescription: schemaDescription,
+		schemaVersion:     schemaVersion,
+		stateUpgraders:    stateUpgraders,
+		terraformType:     ResourceTerraformType,
+		uciConfig:         uciConfig,
+		uciType:           uciType,
+	}
+}
+
+// NewSingletonResource constructs a resource for a type that there should only be one section of.
+// E.g. `firewall.defaults`.
+// Rather than creating a new section,
+// the first section of the type is adopted (and renamed if the `id` asks for it).
+// Destroying the resource leaves the section in place.
+func NewSingletonResource[Model any](
+	configValidators []frameworkresource.ConfigValidator,
+	getId func(Model) types.String,
+	schemaAttributes map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options],
+	schemaDescription string,
+	schemaVersion int64,
+	stateUpgraders map[int64]frameworkresource.StateUpgrader,
+	uciConfig string,
+	uciType string,
+) frameworkresource.Resource {
+	return &resource[Model]{
+		configValidators:  configValidators,
+		getId:             getId,
+		getUCIType:        func(Model) string { return uciType },
+		schemaAttributes:  ownExtraOptions(schemaAttributes),
+		schemaDescription: schemaDescription,
+		schemaVersion:     schemaVersion,
+		singleton:         true,
+		stateUpgraders:    stateUpgraders,
 		terraformType:     ResourceTerraformType,
 		uciConfig:         uciConfig,
 		uciType:           uciType,
@@ -39,15 +107,27 @@ func NewResource[Model any](
 
 type resource[Model any] struct {
 	client            lucirpc.Client
+	configValidators  []frameworkresource.ConfigValidator
 	fullTypeName      string
 	getId             func(Model) types.String
+	getUCIType        func(Model) string
 	schemaAttributes  map[string]SchemaAttribute[Model, lucirpc.Options, lucirpc.Options]
 	schemaDescription string
+	schemaVersion     int64
+	singleton         bool
+	stateUpgraders    map[int64]frameworkresource.StateUpgrader
 	terraformType     string
 	uciConfig         string
 	uciType           string
 }
 
+// ConfigValidators returns the validators that check the resource's configuration as a whole.
+func (d *resource[Model]) ConfigValidators(
+	ctx context.Context,
+) []frameworkresource.ConfigValidator {
+	return d.configValidators
+}
+
 // Configure adds the provider configured client to the resource.
 func (d *resource[Model]) Configure(
 	ctx context.Context,
@@ -97,19 +177,39 @@ func (d *resource[Model]) Create(
 		return
 	}
 
-	id := d.getId(model).ValueString()
-	ctx = tflog.SetField(ctx, "section", fmt.Sprintf("%s.%s", d.uciConfig, id))
-	diagnostics = CreateSection(
-		ctx,
-		d.client,
-		d.uciConfig,
-		d.uciType,
-		id,
-		options,
-	)
-	res.Diagnostics.Append(diagnostics...)
-	if res.Diagnostics.HasError() {
-		return
+	var id string
+	if d.singleton {
+		tflog.Debug(ctx, "Adopting the first section of the type")
+		ctx, id, diagnostics = d.adoptFirstSection(ctx, model, options)
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		uciType := d.getUCIType(model)
+		id = d.getId(model).ValueString()
+		if d.getId(model).IsNull() || d.getId(model).IsUnknown() {
+			tflog.Debug(ctx, "Generating a name for the section")
+			id, diagnostics = GenerateSectionName(uciType)
+			res.Diagnostics.Append(diagnostics...)
+			if res.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		ctx = tflog.SetField(ctx, "section", fmt.Sprintf("%s.%s", d.uciConfig, id))
+		diagnostics = CreateSection(
+			ctx,
+			d.client,
+			d.uciConfig,
+			uciType,
+			id,
+			options,
+		)
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	tflog.Debug(ctx, "Reading updated section")
@@ -119,6 +219,7 @@ func (d *resource[Model]) Create(
 		d.terraformType,
 		d.client,
 		d.schemaAttributes,
+		model,
 		d.uciConfig,
 		id,
 	)
@@ -136,6 +237,7 @@ func (d *resource[Model]) Create(
 }
 
 // Delete removes the actual resource and remove the Terraform state on success.
+// Singleton sections are only removed from the Terraform state.
 func (d *resource[Model]) Delete(
 	ctx context.Context,
 	req frameworkresource.DeleteRequest,
@@ -154,6 +256,11 @@ func (d *resource[Model]) Delete(
 	ctx = logger.SetFieldString(ctx, d.fullTypeName, d.terraformType, IdAttribute, d.getId(model))
 	id := d.getId(model).ValueString()
 	ctx = tflog.SetField(ctx, "section", fmt.Sprintf("%s.%s", d.uciConfig, id))
+	if d.singleton {
+		tflog.Debug(ctx, "Leaving the section in place, since there should always be one")
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting existing section")
 	diagnostics = DeleteSection(
 		ctx,
@@ -168,13 +275,58 @@ func (d *resource[Model]) Delete(
 }
 
 // ImportState brings an existing resource into Terraform state.
+//
+// Resources with a required `id` are imported by their name as-is.
+// Otherwise, the section is looked up so it can be imported by position (e.g. `@rule[0]`).
+// Nothing is changed on the device while importing.
 func (d *resource[Model]) ImportState(
 	ctx context.Context,
 	req frameworkresource.ImportStateRequest,
 	res *frameworkresource.ImportStateResponse,
 ) {
-	tflog.Debug(ctx, "Retrieving import id and saving to id attribute")
-	frameworkresource.ImportStatePassthroughID(ctx, path.Root(IdAttribute), req, res)
+	if d.schemaAttributes[IdAttribute].ToResource().IsRequired() {
+		tflog.Debug(ctx, "Retrieving import id and saving to id attribute")
+		frameworkresource.ImportStatePassthroughID(ctx, path.Root(IdAttribute), req, res)
+		return
+	}
+
+	tflog.Debug(ctx, "Retrieving import id")
+	section, diagnostics := GetSection(
+		ctx,
+		d.client,
+		d.uciConfig,
+		req.ID,
+	)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, id, diagnostics := GetMetadataString(ctx, d.fullTypeName, d.terraformType, section, idUCISection)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, anonymous, diagnostics := GetMetadataBool(ctx, d.fullTypeName, d.terraformType, section, anonymousUCISection)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Saving anonymous flag to private state")
+	diagnostics = setPrivateAnonymous(ctx, res.Private, anonymous.ValueBool())
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Saving id to id attribute")
+	diagnostics = res.State.SetAttribute(ctx, path.Root(IdAttribute), id)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Metadata sets the resource type name.
@@ -186,6 +338,39 @@ func (d *resource[Model]) Metadata(
 	res.TypeName = d.getFullTypeName(req.ProviderTypeName)
 }
 
+// ModifyPlan plans a generated name for imported anonymous sections that were not given an `id`.
+// UCI changes the name of an anonymous section whenever the section changes,
+// so it's renamed on the first apply to give it a stable name.
+func (d *resource[Model]) ModifyPlan(
+	ctx context.Context,
+	req frameworkresource.ModifyPlanRequest,
+	res *frameworkresource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	anonymous, diagnostics := getPrivateAnonymous(ctx, req.Private)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() || !anonymous {
+		return
+	}
+
+	var id types.String
+	diagnostics = req.Config.GetAttribute(ctx, path.Root(IdAttribute), &id)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() || !id.IsNull() {
+		return
+	}
+
+	tflog.Debug(ctx, "Planning a generated name for the anonymous section")
+	diagnostics = res.Plan.SetAttribute(ctx, path.Root(IdAttribute), types.StringUnknown())
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (d *resource[Model]) Read(
 	ctx context.Context,
@@ -208,6 +393,7 @@ func (d *resource[Model]) Read(
 		d.terraformType,
 		d.client,
 		d.schemaAttributes,
+		model,
 		d.uciConfig,
 		d.getId(model).ValueString(),
 	)
@@ -238,6 +424,7 @@ func (d *resource[Model]) Schema(
 	res.Schema = schema.Schema{
 		Attributes:  attributes,
 		Description: d.schemaDescription,
+		Version:     d.schemaVersion,
 	}
 }
 
@@ -268,7 +455,55 @@ func (d *resource[Model]) Update(
 		return
 	}
 
+	tflog.Debug(ctx, "Retrieving values from state")
+	var priorModel Model
+	diagnostics = req.State.Get(ctx, &priorModel)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
 	id := d.getId(model).ValueString()
+	if d.getId(model).IsUnknown() {
+		tflog.Debug(ctx, "Generating a name for the anonymous section")
+		id, diagnostics = GenerateSectionName(d.getUCIType(model))
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	priorId := d.getId(priorModel).ValueString()
+	if priorId != id {
+		tflog.Debug(ctx, fmt.Sprintf("Renaming section %s.%s to %s.%s", d.uciConfig, priorId, d.uciConfig, id))
+		diagnostics = RenameSection(
+			ctx,
+			d.client,
+			d.uciConfig,
+			priorId,
+			id,
+		)
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+
+		// The section is renamed on the device now.
+		// Record the new id so a failure below doesn't leave the state pointing at a section that no longer exists.
+		tflog.Debug(ctx, "Saving renamed id to id attribute")
+		diagnostics = res.State.SetAttribute(ctx, path.Root(IdAttribute), types.StringValue(id))
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+
+		diagnostics = setPrivateAnonymous(ctx, res.Private, false)
+		res.Diagnostics.Append(diagnostics...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	ctx = tflog.SetField(ctx, "section", fmt.Sprintf("%s.%s", d.uciConfig, id))
 	diagnostics = UpdateSection(
 		ctx,
@@ -289,6 +524,7 @@ func (d *resource[Model]) Update(
 		d.terraformType,
 		d.client,
 		d.schemaAttributes,
+		model,
 		d.uciConfig,
 		id,
 	)
@@ -305,6 +541,87 @@ func (d *resource[Model]) Update(
 	}
 }
 
+// UpgradeState returns the state upgraders keyed by the schema version they upgrade from.
+func (d *resource[Model]) UpgradeState(
+	ctx context.Context,
+) map[int64]frameworkresource.StateUpgrader {
+	return d.stateUpgraders
+}
+
+// adoptFirstSection takes over the first section of the type instead of creating a new one.
+// The section is renamed to the planned `id`.
+// If no `id` is planned, an anonymous section is given a generated name, since UCI changes its name whenever it changes.
+// The name of the adopted section is returned.
+func (d resource[Model]) adoptFirstSection(
+	ctx context.Context,
+	model Model,
+	options lucirpc.Options,
+) (context.Context, string, diag.Diagnostics) {
+	allDiagnostics := diag.Diagnostics{}
+
+	section, diagnostics := GetSection(
+		ctx,
+		d.client,
+		d.uciConfig,
+		firstSectionOfType(d.uciType),
+	)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, "", allDiagnostics
+	}
+
+	ctx, existingId, diagnostics := GetMetadataString(ctx, d.fullTypeName, d.terraformType, section, idUCISection)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, "", allDiagnostics
+	}
+
+	ctx, anonymous, diagnostics := GetMetadataBool(ctx, d.fullTypeName, d.terraformType, section, anonymousUCISection)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, "", allDiagnostics
+	}
+
+	id := d.getId(model).ValueString()
+	if d.getId(model).IsNull() || d.getId(model).IsUnknown() {
+		id = existingId.ValueString()
+		if anonymous.ValueBool() {
+			tflog.Debug(ctx, "Generating a name for the anonymous section")
+			id, diagnostics = GenerateSectionName(d.uciType)
+			allDiagnostics.Append(diagnostics...)
+			if allDiagnostics.HasError() {
+				return ctx, "", allDiagnostics
+			}
+		}
+	}
+
+	if existingId.ValueString() != id {
+		tflog.Debug(ctx, fmt.Sprintf("Renaming section %s.%s to %s.%s", d.uciConfig, existingId.ValueString(), d.uciConfig, id))
+		diagnostics = RenameSection(
+			ctx,
+			d.client,
+			d.uciConfig,
+			existingId.ValueString(),
+			id,
+		)
+		allDiagnostics.Append(diagnostics...)
+		if allDiagnostics.HasError() {
+			return ctx, "", allDiagnostics
+		}
+	}
+
+	ctx = tflog.SetField(ctx, "section", fmt.Sprintf("%s.%s", d.uciConfig, id))
+	diagnostics = UpdateSection(
+		ctx,
+		d.client,
+		d.uciConfig,
+		id,
+		options,
+	)
+	allDiagnostics.Append(diagnostics...)
+	return ctx, id, allDiagnostics
+}
+
 func (d resource[Model]) getFullTypeName(
 	providerTypeName string,
 ) string {
@@ -312,3 +629,33 @@ func (d resource[Model]) getFullTypeName(
 	uciType := strings.ReplaceAll(d.uciType, "-", "_")
 	return fmt.Sprintf("%s_%s_%s", providerTypeName, uciConfig, uciType)
 }
+
+const (
+	anonymousPrivateStateKey = "anonymous"
+)
+
+type privateStateGetter interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+// getPrivateAnonymous reports whether the section was anonymous when it was imported and has not been renamed since.
+func getPrivateAnonymous(
+	ctx context.Context,
+	private privateStateGetter,
+) (bool, diag.Diagnostics) {
+	value, diagnostics := private.GetKey(ctx, anonymousPrivateStateKey)
+	return string(value) == "true", diagnostics
+}
+
+// setPrivateAnonymous records whether the section is anonymous.
+func setPrivateAnonymous(
+	ctx context.Context,
+	private privateStateSetter,
+	anonymous bool,
+) diag.Diagnostics {
+	return private.SetKey(ctx, anonymousPrivateStateKey, []byte(fmt.Sprintf("%t", anonymous)))
+}
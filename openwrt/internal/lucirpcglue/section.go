@@ -2,7 +2,10 @@ package lucirpcglue
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
@@ -78,7 +81,28 @@ func DeleteSection(
 	return diagnostics
 }
 
-// GetMetadataString attempts to parse the given metadata key from the section.
+// GenerateSectionName generates a name for a section that was not given one.
+// The name is prefixed with the section type so it's recognizable when interacting with UCI directly.
+// Any diagnostic information found in the process (including errors) is returned.
+func GenerateSectionName(
+	uciType string,
+) (string, diag.Diagnostics) {
+	diagnostics := diag.Diagnostics{}
+	suffix := make([]byte, 4)
+	_, err := rand.Read(suffix)
+	if err != nil {
+		diagnostics.AddError(
+			fmt.Sprintf("problem generating a name for %s section", uciType),
+			err.Error(),
+		)
+		return "", diagnostics
+	}
+
+	prefix := strings.ReplaceAll(uciType, "-", "_")
+	return fmt.Sprintf("%s_%s", prefix, hex.EncodeToString(suffix)), diagnostics
+}
+
+// GetSection attempts to find the given section.
 // Any diagnostic information found in the process (including errors) is returned.
 func GetSection(
 	ctx context.Context,
@@ -99,6 +123,41 @@ func GetSection(
 	return result, diagnostics
 }
 
+// RenameSection attempts to rename an existing section.
+// Any diagnostic information found in the process (including errors) is returned.
+func RenameSection(
+	ctx context.Context,
+	client lucirpc.Client,
+	config string,
+	section string,
+	name string,
+) diag.Diagnostics {
+	diagnostics := diag.Diagnostics{}
+	result, err := client.RenameSection(
+		ctx,
+		config,
+		section,
+		name,
+	)
+	if err != nil {
+		diagnostics.AddError(
+			fmt.Sprintf("problem renaming %s.%s section to %s.%s", config, section, config, name),
+			err.Error(),
+		)
+		return diagnostics
+	}
+
+	if !result {
+		diagnostics.AddError(
+			fmt.Sprintf("Could not rename %s.%s section to %s.%s", config, section, config, name),
+			"It is not currently known why this happens. It is unclear if this is a problem with the provider. Please double check the values provided are acceptable.",
+		)
+		return diagnostics
+	}
+
+	return diagnostics
+}
+
 // UpdateSection attempts to update an existing section.
 // Any diagnostic information found in the process (including errors) is returned.
 func UpdateSection(
@@ -133,3 +192,11 @@ func UpdateSection(
 
 	return diagnostics
 }
+
+// firstSectionOfType refers to the first section of the given type using UCI's extended syntax.
+// E.g. `@defaults[0]`.
+func firstSectionOfType(
+	uciType string,
+) string {
+	return fmt.Sprintf("@%s[0]", uciType)
+}
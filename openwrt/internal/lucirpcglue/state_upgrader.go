@@ -0,0 +1,66 @@
+package lucirpcglue
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+)
+
+// StateUpgrader constructs a state upgrader from a prior version of a resource's schema.
+// The prior state is read using the prior schema attributes,
+// and the upgrade function converts it to the current model.
+//
+// If the resource had an `extra_options` attribute at the prior version,
+// the prior schema attributes must include it as well,
+// or the prior state will fail to decode.
+//
+// The returned value should be registered with `NewResource`,
+// keyed by the schema version it upgrades from.
+func StateUpgrader[PriorModel any, Model any](
+	priorSchemaAttributes map[string]SchemaAttribute[PriorModel, lucirpc.Options, lucirpc.Options],
+	upgrade func(context.Context, PriorModel) (Model, diag.Diagnostics),
+) frameworkresource.StateUpgrader {
+	attributes := map[string]schema.Attribute{}
+	for k, v := range ownExtraOptions(priorSchemaAttributes) {
+		attributes[k] = v.ToResource()
+	}
+
+	priorSchema := schema.Schema{
+		Attributes: attributes,
+	}
+
+	return frameworkresource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(
+			ctx context.Context,
+			req frameworkresource.UpgradeStateRequest,
+			res *frameworkresource.UpgradeStateResponse,
+		) {
+			tflog.Debug(ctx, "Retrieving values from prior state")
+			var priorModel PriorModel
+			diagnostics := req.State.Get(ctx, &priorModel)
+			res.Diagnostics.Append(diagnostics...)
+			if res.Diagnostics.HasError() {
+				return
+			}
+
+			tflog.Debug(ctx, "Upgrading prior state")
+			model, diagnostics := upgrade(ctx, priorModel)
+			res.Diagnostics.Append(diagnostics...)
+			if res.Diagnostics.HasError() {
+				return
+			}
+
+			tflog.Debug(ctx, "Updating state with upgraded values")
+			diagnostics = res.State.Set(ctx, model)
+			res.Diagnostics.Append(diagnostics...)
+			if res.Diagnostics.HasError() {
+				return
+			}
+		},
+	}
+}
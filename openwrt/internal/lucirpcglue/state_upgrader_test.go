@@ -0,0 +1,155 @@
+package lucirpcglue_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+	"gotest.tools/v3/assert"
+)
+
+func TestStateUpgrader(t *testing.T) {
+	t.Run("upgrades a v0 state to v1", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		upgrader := lucirpcglue.StateUpgrader(testSchemaAttributesV0, testUpgradeStateV0)
+		extraOptionsType := types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"value":  types.StringType,
+				"values": types.ListType{ElemType: types.StringType},
+			},
+		}
+		extraOptions, diagnostics := types.MapValue(
+			extraOptionsType,
+			map[string]attr.Value{
+				"option1": types.ObjectValueMust(
+					extraOptionsType.AttrTypes,
+					map[string]attr.Value{
+						"value":  types.StringValue("value1"),
+						"values": types.ListNull(types.StringType),
+					},
+				),
+			},
+		)
+		assert.Assert(t, !diagnostics.HasError(), diagnostics)
+		priorState := tfsdk.State{
+			Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+			Schema: *upgrader.PriorSchema,
+		}
+		diagnostics = priorState.Set(ctx, testModelV0{
+			ExtraOptions: extraOptions,
+			Id:           types.StringValue("section1"),
+			Name:         types.StringValue("name1 name2"),
+		})
+		assert.Assert(t, !diagnostics.HasError(), diagnostics)
+		schemaResponse := frameworkresource.SchemaResponse{}
+		testResource().Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResponse)
+		req := frameworkresource.UpgradeStateRequest{
+			State: &priorState,
+		}
+		res := frameworkresource.UpgradeStateResponse{
+			State: tfsdk.State{
+				Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+				Schema: schemaResponse.Schema,
+			},
+		}
+
+		// When
+		upgrader.StateUpgrader(ctx, req, &res)
+
+		// Then
+		assert.Assert(t, !res.Diagnostics.HasError(), res.Diagnostics)
+		var got testModel
+		diagnostics = res.State.Get(ctx, &got)
+		assert.Assert(t, !diagnostics.HasError(), diagnostics)
+		assert.Assert(t, got.ExtraOptions.Equal(extraOptions))
+		assert.Assert(t, got.Id.Equal(types.StringValue("section1")))
+		wantNames := types.ListValueMust(
+			types.StringType,
+			[]attr.Value{
+				types.StringValue("name1"),
+				types.StringValue("name2"),
+			},
+		)
+		assert.Assert(t, got.Names.Equal(wantNames), got.Names)
+	})
+}
+
+type testModel struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Names        types.List   `tfsdk:"names"`
+}
+
+type testModelV0 struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+}
+
+var (
+	testSchemaAttributes = map[string]lucirpcglue.SchemaAttribute[testModel, lucirpc.Options, lucirpc.Options]{
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(
+			func(m testModel) types.Map { return m.ExtraOptions },
+			func(m *testModel, value types.Map) { m.ExtraOptions = value },
+		),
+		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(
+			func(m testModel) types.String { return m.Id },
+			func(m *testModel, value types.String) { m.Id = value },
+		),
+		"names": lucirpcglue.ListStringSchemaAttribute[testModel, lucirpc.Options, lucirpc.Options]{
+			ResourceExistence: lucirpcglue.NoValidation,
+		},
+	}
+
+	testSchemaAttributesV0 = map[string]lucirpcglue.SchemaAttribute[testModelV0, lucirpc.Options, lucirpc.Options]{
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(
+			func(m testModelV0) types.Map { return m.ExtraOptions },
+			func(m *testModelV0, value types.Map) { m.ExtraOptions = value },
+		),
+		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(
+			func(m testModelV0) types.String { return m.Id },
+			func(m *testModelV0, value types.String) { m.Id = value },
+		),
+		"name": lucirpcglue.StringSchemaAttribute[testModelV0, lucirpc.Options, lucirpc.Options]{
+			ResourceExistence: lucirpcglue.NoValidation,
+		},
+	}
+)
+
+func testResource() frameworkresource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		func(m testModel) types.String { return m.Id },
+		testSchemaAttributes,
+		"",
+		1,
+		nil,
+		"config",
+		"type",
+	)
+}
+
+func testUpgradeStateV0(
+	ctx context.Context,
+	prior testModelV0,
+) (testModel, diag.Diagnostics) {
+	names, diagnostics := types.ListValueFrom(
+		ctx,
+		types.StringType,
+		strings.Fields(prior.Name.ValueString()),
+	)
+	return testModel{
+		ExtraOptions: prior.ExtraOptions,
+		Id:           prior.Id,
+		Names:        names,
+	}, diagnostics
+}
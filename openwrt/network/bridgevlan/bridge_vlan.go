@@ -0,0 +1,101 @@
+package bridgevlan
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	deviceAttribute            = "device"
+	deviceAttributeDescription = "Name of the bridge device the VLAN belongs to (e.g. \"br-lan\"). This is the `name` of an `openwrt_network_device`."
+	deviceUCIOption            = "device"
+
+	schemaDescription = "A VLAN on a bridge device. This is how VLANs are configured on devices that use DSA (Distributed Switch Architecture), which replaced `swconfig` switches in OpenWrt 21.02."
+	schemaVersion     = 0
+
+	uciConfig = "network"
+	uciType   = "bridge-vlan"
+
+	vlanAttribute            = "vlan"
+	vlanAttributeDescription = "The VLAN id. Must be in the range: `[1, 4094]`."
+	vlanUCIOption            = "vlan"
+)
+
+var (
+	deviceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       deviceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDevice, deviceAttribute, deviceUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDevice, deviceAttribute, deviceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		deviceAttribute:                   deviceSchemaAttribute,
+		portsAttribute:                    portsSchemaAttribute{},
+		vlanAttribute:                     vlanSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+	}
+
+	vlanSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       vlanAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetVLAN, vlanAttribute, vlanUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetVLAN, vlanAttribute, vlanUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 4094),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	Device       types.String `tfsdk:"device"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Ports        types.Set    `tfsdk:"ports"`
+	VLAN         types.Int64  `tfsdk:"vlan"`
+}
+
+func modelGetDevice(m model) types.String    { return m.Device }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetPorts(m model) types.Set        { return m.Ports }
+func modelGetVLAN(m model) types.Int64       { return m.VLAN }
+
+func modelSetDevice(m *model, value types.String)    { m.Device = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetPorts(m *model, value types.Set)        { m.Ports = value }
+func modelSetVLAN(m *model, value types.Int64)       { m.VLAN = value }
@@ -0,0 +1,238 @@
+//go:build acceptance.test
+
+package bridgevlan_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"device": lucirpc.String("br-lan"),
+		"ports":  lucirpc.ListString([]string{"lan1:u*", "lan2:t"}),
+		"vlan":   lucirpc.Integer(10),
+	}
+	ok, err := client.CreateSection(ctx, "network", "bridge-vlan", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_network_bridge_vlan" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_network_bridge_vlan.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_network_bridge_vlan.testing", "device", "br-lan"),
+			resource.TestCheckResourceAttr("data.openwrt_network_bridge_vlan.testing", "ports.#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs("data.openwrt_network_bridge_vlan.testing", "ports.*", map[string]string{
+				"name":    "lan1",
+				"primary": "true",
+				"tagged":  "false",
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs("data.openwrt_network_bridge_vlan.testing", "ports.*", map[string]string{
+				"name":    "lan2",
+				"primary": "false",
+				"tagged":  "true",
+			}),
+			resource.TestCheckResourceAttr("data.openwrt_network_bridge_vlan.testing", "vlan", "10"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_bridge_vlan" "testing" {
+	device = "br-lan"
+	id = "testing"
+	ports = [
+		{
+			name = "lan1"
+			primary = true
+		},
+		{
+			name = "lan2"
+			tagged = true
+		},
+	]
+	vlan = 10
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "device", "br-lan"),
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "ports.#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs("openwrt_network_bridge_vlan.testing", "ports.*", map[string]string{
+				"name":    "lan1",
+				"primary": "true",
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs("openwrt_network_bridge_vlan.testing", "ports.*", map[string]string{
+				"name":   "lan2",
+				"tagged": "true",
+			}),
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "vlan", "10"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_bridge_vlan.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_bridge_vlan" "testing" {
+	device = "br-lan"
+	id = "testing"
+	ports = [
+		{
+			name = "lan1"
+			primary = false
+			tagged = true
+		},
+		{
+			name = "lan3"
+		},
+	]
+	vlan = 20
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "ports.#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs("openwrt_network_bridge_vlan.testing", "ports.*", map[string]string{
+				"name":    "lan1",
+				"primary": "false",
+				"tagged":  "true",
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs("openwrt_network_bridge_vlan.testing", "ports.*", map[string]string{
+				"name": "lan3",
+			}),
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "vlan", "20"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceAnonymousAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_bridge_vlan" "testing" {
+	device = "br-lan"
+	ports = [
+		{
+			name = "lan1"
+		},
+	]
+	vlan = 10
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_network_bridge_vlan.testing", "id", regexp.MustCompile("^bridge_vlan_[[:xdigit:]]{8}$")),
+			resource.TestCheckResourceAttr("openwrt_network_bridge_vlan.testing", "ports.#", "1"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+	)
+}
+
+func TestResourceInvalidPortAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_bridge_vlan" "testing" {
+	device = "br-lan"
+	id = "testing"
+	ports = [
+		{
+			name = "lan1:t"
+		},
+	]
+	vlan = 10
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,258 @@
+package bridgevlan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/logger"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	portsAttribute            = "ports"
+	portsAttributeDescription = "Ports that are members of the VLAN. UCI stores each port as a string like `\"lan1:u*\"`."
+	portsUCIOption            = "ports"
+
+	portsNameAttribute            = "name"
+	portsNameAttributeDescription = "Name of the port (e.g. \"lan1\")."
+
+	portsPrimaryAttribute            = "primary"
+	portsPrimaryAttributeDescription = "Untagged traffic arriving on the port is assigned to this VLAN (i.e. this is the PVID of the port). A port should only be primary in one VLAN. If unset, `false` is used."
+	portsPrimarySuffix               = "*"
+
+	portsTaggedAttribute            = "tagged"
+	portsTaggedAttributeDescription = "Traffic leaving the port is tagged with this VLAN. If unset, `false` is used, and traffic leaves the port untagged."
+	portsTaggedSuffix               = ":t"
+	portsUntaggedSuffix             = ":u"
+)
+
+var (
+	portElementType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			portsNameAttribute:    types.StringType,
+			portsPrimaryAttribute: types.BoolType,
+			portsTaggedAttribute:  types.BoolType,
+		},
+	}
+
+	portPattern = regexp.MustCompile(`^([^:*]+)(:[tu])?(\*)?$`)
+)
+
+type port struct {
+	Name    types.String `tfsdk:"name"`
+	Primary types.Bool   `tfsdk:"primary"`
+	Tagged  types.Bool   `tfsdk:"tagged"`
+}
+
+// portsSchemaAttribute manages the `ports` list of a `bridge-vlan` section as a set of objects,
+// instead of the encoded strings UCI uses.
+type portsSchemaAttribute struct{}
+
+// Read parses each port from its UCI representation.
+//
+// A data source reads every flag.
+// A resource reads flags that are `false` as unset,
+// unless the port was already known with the flag set to `false`.
+// That way, both leaving a flag unset and setting it to `false` are stable.
+func (a portsSchemaAttribute) Read(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	section lucirpc.Options,
+	m model,
+) (context.Context, model, diag.Diagnostics) {
+	allDiagnostics := diag.Diagnostics{}
+	ctx, encoded, diagnostics := lucirpcglue.GetOptionSetString(ctx, fullTypeName, terraformType, section, path.Root(portsAttribute), portsUCIOption)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, m, allDiagnostics
+	}
+
+	if encoded.IsNull() {
+		m.Ports = types.SetNull(portElementType)
+		return ctx, m, allDiagnostics
+	}
+
+	known := map[string]port{}
+	if !m.Ports.IsNull() && !m.Ports.IsUnknown() {
+		var ports []port
+		diagnostics = m.Ports.ElementsAs(ctx, &ports, false)
+		allDiagnostics.Append(diagnostics...)
+		if allDiagnostics.HasError() {
+			return ctx, m, allDiagnostics
+		}
+
+		for _, p := range ports {
+			known[p.Name.ValueString()] = p
+		}
+	}
+
+	var values []string
+	diagnostics = encoded.ElementsAs(ctx, &values, false)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, m, allDiagnostics
+	}
+
+	ports := []port{}
+	for _, value := range values {
+		matches := portPattern.FindStringSubmatch(value)
+		if matches == nil {
+			allDiagnostics.AddAttributeError(
+				path.Root(portsAttribute),
+				fmt.Sprintf("unable to parse option: %q", portsUCIOption),
+				fmt.Sprintf("expected a port like %q, got: %q", "lan1:u*", value),
+			)
+			continue
+		}
+
+		name := matches[1]
+		primary := types.BoolValue(matches[3] == portsPrimarySuffix)
+		tagged := types.BoolValue(matches[2] == portsTaggedSuffix)
+		if terraformType == lucirpcglue.ResourceTerraformType {
+			primary = readFlag(primary, known[name].Primary)
+			tagged = readFlag(tagged, known[name].Tagged)
+		}
+
+		ports = append(ports, port{
+			Name:    types.StringValue(name),
+			Primary: primary,
+			Tagged:  tagged,
+		})
+	}
+
+	if allDiagnostics.HasError() {
+		return ctx, m, allDiagnostics
+	}
+
+	m.Ports, diagnostics = types.SetValueFrom(ctx, portElementType, ports)
+	allDiagnostics.Append(diagnostics...)
+	return ctx, m, allDiagnostics
+}
+
+func (a portsSchemaAttribute) ToDataSource() datasourceschema.Attribute {
+	return datasourceschema.SetNestedAttribute{
+		Computed:    true,
+		Description: portsAttributeDescription,
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				portsNameAttribute: datasourceschema.StringAttribute{
+					Computed:    true,
+					Description: portsNameAttributeDescription,
+				},
+				portsPrimaryAttribute: datasourceschema.BoolAttribute{
+					Computed:    true,
+					Description: portsPrimaryAttributeDescription,
+				},
+				portsTaggedAttribute: datasourceschema.BoolAttribute{
+					Computed:    true,
+					Description: portsTaggedAttributeDescription,
+				},
+			},
+		},
+	}
+}
+
+func (a portsSchemaAttribute) ToResource() resourceschema.Attribute {
+	return resourceschema.SetNestedAttribute{
+		Description: portsAttributeDescription,
+		NestedObject: resourceschema.NestedAttributeObject{
+			Attributes: map[string]resourceschema.Attribute{
+				portsNameAttribute: resourceschema.StringAttribute{
+					Description: portsNameAttributeDescription,
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[^:*[:space:]]+$`),
+							`must be a port name without ":", "*", or whitespace`,
+						),
+					},
+				},
+				portsPrimaryAttribute: resourceschema.BoolAttribute{
+					Description: portsPrimaryAttributeDescription,
+					Optional:    true,
+				},
+				portsTaggedAttribute: resourceschema.BoolAttribute{
+					Description: portsTaggedAttributeDescription,
+					Optional:    true,
+				},
+			},
+		},
+		Required: true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+}
+
+// Upsert encodes each port the way UCI expects it (e.g. `"lan1:u*"`).
+func (a portsSchemaAttribute) Upsert(
+	ctx context.Context,
+	fullTypeName string,
+	options lucirpc.Options,
+	m model,
+) (context.Context, lucirpc.Options, diag.Diagnostics) {
+	allDiagnostics := diag.Diagnostics{}
+	if m.Ports.IsNull() || m.Ports.IsUnknown() {
+		return ctx, options, allDiagnostics
+	}
+
+	var ports []port
+	diagnostics := m.Ports.ElementsAs(ctx, &ports, false)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, options, allDiagnostics
+	}
+
+	values := []string{}
+	for _, p := range ports {
+		var value strings.Builder
+		value.WriteString(p.Name.ValueString())
+		if p.Tagged.ValueBool() {
+			value.WriteString(portsTaggedSuffix)
+		} else {
+			value.WriteString(portsUntaggedSuffix)
+		}
+
+		if p.Primary.ValueBool() {
+			value.WriteString(portsPrimarySuffix)
+		}
+
+		values = append(values, value.String())
+	}
+
+	encoded, diagnostics := types.SetValueFrom(ctx, types.StringType, values)
+	allDiagnostics.Append(diagnostics...)
+	if allDiagnostics.HasError() {
+		return ctx, options, allDiagnostics
+	}
+
+	options[portsUCIOption] = lucirpc.ListString(values)
+	ctx = logger.SetFieldSetString(ctx, fullTypeName, lucirpcglue.ResourceTerraformType, portsAttribute, encoded)
+	return ctx, options, allDiagnostics
+}
+
+// readFlag returns the flag as read from UCI,
+// keeping an explicit `false` only if that's how the port was already known.
+func readFlag(
+	value types.Bool,
+	known types.Bool,
+) types.Bool {
+	if value.ValueBool() || (!known.IsNull() && !known.IsUnknown()) {
+		return value
+	}
+
+	return types.BoolNull()
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
@@ -29,7 +32,8 @@ const (
 	dadTransmitsUCIOption            = "dadtransmits"
 
 	enableIPv6Attribute            = "ipv6"
-	enableIPv6AttributeDescription = "Enable IPv6 for the device."
+	enableIPv6AttributeDescription = "Enable IPv6 for the device. Defaults to `true`."
+	enableIPv6DefaultValue         = true
 	enableIPv6UCIOption            = "ipv6"
 
 	macAddressAttribute            = "macaddr"
@@ -49,6 +53,7 @@ const (
 	nameUCIOption            = "name"
 
 	schemaDescription = `A physical or virtual "device" in OpenWrt jargon. Commonly referred to as an "interface" in other networking jargon.`
+	schemaVersion     = 0
 
 	txQueueLengthAttribute            = "txqueuelen"
 	txQueueLengthAttributeDescription = "Transmission queue length."
@@ -106,6 +111,7 @@ var (
 	}
 
 	enableIPv6SchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enableIPv6DefaultValue),
 		Description:       enableIPv6AttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnableIPv6, enableIPv6Attribute, enableIPv6UCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -157,17 +163,18 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		bridgePortsAttribute:        bridgePortsSchemaAttribute,
-		bringUpEmptyBridgeAttribute: bringUpEmptyBridgeSchemaAttribute,
-		dadTransmitsAttribute:       dadTransmitsSchemaAttribute,
-		enableIPv6Attribute:         enableIPv6SchemaAttribute,
-		macAddressAttribute:         macAddressSchemaAttribute,
-		mtuAttribute:                mtuSchemaAttribute,
-		mtu6Attribute:               mtu6SchemaAttribute,
-		nameAttribute:               nameSchemaAttribute,
-		txQueueLengthAttribute:      txQueueLengthSchemaAttribute,
-		typeAttribute:               typeSchemaAttribute,
-		lucirpcglue.IdAttribute:     lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		bridgePortsAttribute:              bridgePortsSchemaAttribute,
+		bringUpEmptyBridgeAttribute:       bringUpEmptyBridgeSchemaAttribute,
+		dadTransmitsAttribute:             dadTransmitsSchemaAttribute,
+		enableIPv6Attribute:               enableIPv6SchemaAttribute,
+		macAddressAttribute:               macAddressSchemaAttribute,
+		mtuAttribute:                      mtuSchemaAttribute,
+		mtu6Attribute:                     mtu6SchemaAttribute,
+		nameAttribute:                     nameSchemaAttribute,
+		txQueueLengthAttribute:            txQueueLengthSchemaAttribute,
+		typeAttribute:                     typeSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
 	}
 
 	txQueueLengthSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
@@ -181,7 +188,10 @@ var (
 	}
 
 	typeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       typeAttributeDescription,
+		Description: typeAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetType, typeAttribute, typeUCIOption),
 		ResourceExistence: lucirpcglue.Required,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetType, typeAttribute, typeUCIOption),
@@ -205,9 +215,12 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
@@ -218,6 +231,7 @@ type model struct {
 	BringUpEmptyBridge types.Bool   `tfsdk:"bridge_empty"`
 	DADTransmits       types.Int64  `tfsdk:"dadtransmits"`
 	EnableIPv6         types.Bool   `tfsdk:"ipv6"`
+	ExtraOptions       types.Map    `tfsdk:"extra_options"`
 	Id                 types.String `tfsdk:"id"`
 	MacAddress         types.String `tfsdk:"macaddr"`
 	MTU                types.Int64  `tfsdk:"mtu"`
@@ -231,6 +245,7 @@ func modelGetBridgePorts(m model) types.Set         { return m.BridgePorts }
 func modelGetBringUpEmptyBridge(m model) types.Bool { return m.BringUpEmptyBridge }
 func modelGetDADTransmits(m model) types.Int64      { return m.DADTransmits }
 func modelGetEnableIPv6(m model) types.Bool         { return m.EnableIPv6 }
+func modelGetExtraOptions(m model) types.Map        { return m.ExtraOptions }
 func modelGetId(m model) types.String               { return m.Id }
 func modelGetMacAddress(m model) types.String       { return m.MacAddress }
 func modelGetMTU(m model) types.Int64               { return m.MTU }
@@ -243,6 +258,7 @@ func modelSetBridgePorts(m *model, value types.Set)         { m.BridgePorts = va
 func modelSetBringUpEmptyBridge(m *model, value types.Bool) { m.BringUpEmptyBridge = value }
 func modelSetDADTransmits(m *model, value types.Int64)      { m.DADTransmits = value }
 func modelSetEnableIPv6(m *model, value types.Bool)         { m.EnableIPv6 = value }
+func modelSetExtraOptions(m *model, value types.Map)        { m.ExtraOptions = value }
 func modelSetId(m *model, value types.String)               { m.Id = value }
 func modelSetMacAddress(m *model, value types.String)       { m.MacAddress = value }
 func modelSetMTU(m *model, value types.Int64)               { m.MTU = value }
@@ -3,16 +3,19 @@ package globals
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
 )
 
 const (
-	packetSteeringAttribute = "packet_steering"
-	packetSteeringUCIOption = "packet_steering"
+	packetSteeringAttribute    = "packet_steering"
+	packetSteeringDefaultValue = false
+	packetSteeringUCIOption    = "packet_steering"
 
 	schemaDescription = "Contains interface-independent options affecting the network configuration in general."
+	schemaVersion     = 0
 
 	uciConfig = "network"
 	uciType   = "globals"
@@ -23,16 +26,18 @@ const (
 
 var (
 	packetSteeringSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       "Use every CPU to handle packet traffic.",
+		Default:           booldefault.StaticBool(packetSteeringDefaultValue),
+		Description:       "Use every CPU to handle packet traffic. Defaults to `false`.",
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetPacketSteering, packetSteeringAttribute, packetSteeringUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetPacketSteering, packetSteeringAttribute, packetSteeringUCIOption),
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		ulaPrefixAttribute:      ulaPrefixSchemaAttribute,
-		packetSteeringAttribute: packetSteeringSchemaAttribute,
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		ulaPrefixAttribute:                ulaPrefixSchemaAttribute,
+		packetSteeringAttribute:           packetSteeringSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
 	}
 
 	ulaPrefixSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
@@ -55,24 +60,30 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
+	ExtraOptions   types.Map    `tfsdk:"extra_options"`
 	Id             types.String `tfsdk:"id"`
 	PacketSteering types.Bool   `tfsdk:"packet_steering"`
 	ULAPrefix      types.String `tfsdk:"ula_prefix"`
 }
 
+func modelGetExtraOptions(m model) types.Map    { return m.ExtraOptions }
 func modelGetId(m model) types.String           { return m.Id }
 func modelGetPacketSteering(m model) types.Bool { return m.PacketSteering }
 func modelGetULAPrefix(m model) types.String    { return m.ULAPrefix }
 
+func modelSetExtraOptions(m *model, value types.Map)    { m.ExtraOptions = value }
 func modelSetId(m *model, value types.String)           { m.Id = value }
 func modelSetPacketSteering(m *model, value types.Bool) { m.PacketSteering = value }
 func modelSetULAPrefix(m *model, value types.String)    { m.ULAPrefix = value }
@@ -0,0 +1,290 @@
+// Package policyrule defines the schema shared by IPv4 (`rule`) and IPv6 (`rule6`) policy routing rules.
+//
+// The two only differ in the addresses they accept,
+// so everything that depends on the address family is passed in as an [AddressFamily].
+package policyrule
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	actionAttribute            = "action"
+	actionAttributeDescription = `What to do with matching traffic instead of looking up a routing table. Must be one of: "blackhole", "prohibit", "throw", "unreachable".`
+	actionBlackhole            = "blackhole"
+	actionProhibit             = "prohibit"
+	actionThrow                = "throw"
+	actionUCIOption            = "action"
+	actionUnreachable          = "unreachable"
+
+	destAttribute = "dest"
+	destUCIOption = "dest"
+
+	gotoAttribute            = "goto"
+	gotoAttributeDescription = "Jump to the rule with this priority."
+	gotoUCIOption            = "goto"
+
+	inAttribute            = "in"
+	inAttributeDescription = "Match traffic coming in on this logical interface. This is the `id` of an `openwrt_network_interface`."
+	inUCIOption            = "in"
+
+	invertAttribute            = "invert"
+	invertAttributeDescription = "Invert the match, so the rule applies to traffic that does not match. Defaults to `false`."
+	invertDefaultValue         = false
+	invertUCIOption            = "invert"
+
+	lookupAttribute            = "lookup"
+	lookupAttributeDescription = `Routing table to look up for matching traffic, by name or number (e.g. "main", "100").`
+	lookupUCIOption            = "lookup"
+
+	markAttribute            = "mark"
+	markAttributeDescription = `Match traffic with this firewall mark, optionally with a mask (e.g. "0x1", "0x1/0xff").`
+	markUCIOption            = "mark"
+
+	outAttribute            = "out"
+	outAttributeDescription = "Match traffic going out on this logical interface. This is the `id` of an `openwrt_network_interface`."
+	outUCIOption            = "out"
+
+	priorityAttribute            = "priority"
+	priorityAttributeDescription = "Priority of the rule. Rules are evaluated from lowest to highest priority."
+	priorityUCIOption            = "priority"
+
+	schemaVersion = 0
+
+	srcAttribute = "src"
+	srcUCIOption = "src"
+
+	tosAttribute            = "tos"
+	tosAttributeDescription = "Match traffic with this type of service value. Must be in the range: `[0, 255]`."
+	tosUCIOption            = "tos"
+
+	uciConfig = "network"
+)
+
+var (
+	actionSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       actionAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetAction, actionAttribute, actionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetAction, actionAttribute, actionUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				actionBlackhole,
+				actionProhibit,
+				actionThrow,
+				actionUnreachable,
+			),
+		},
+	}
+
+	configValidators = []resource.ConfigValidator{
+		lucirpcglue.ExactlyOneOf(
+			path.MatchRoot(actionAttribute),
+			path.MatchRoot(gotoAttribute),
+			path.MatchRoot(lookupAttribute),
+		),
+	}
+
+	gotoSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       gotoAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetGoto, gotoAttribute, gotoUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetGoto, gotoAttribute, gotoUCIOption),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	inSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       inAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIn, inAttribute, inUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetIn, inAttribute, inUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	invertSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(invertDefaultValue),
+		Description:       invertAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetInvert, invertAttribute, invertUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetInvert, invertAttribute, invertUCIOption),
+	}
+
+	lookupSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       lookupAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetLookup, lookupAttribute, lookupUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetLookup, lookupAttribute, lookupUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:alnum:]_-]+$"),
+				`must be a routing table name or number (e.g. "main", "100")`,
+			),
+		},
+	}
+
+	markSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       markAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMark, markAttribute, markUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetMark, markAttribute, markUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^(0x[[:xdigit:]]+|[[:digit:]]+)(/(0x[[:xdigit:]]+|[[:digit:]]+))?$"),
+				`must be a mark, optionally with a mask (e.g. "0x1", "0x1/0xff")`,
+			),
+		},
+	}
+
+	outSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       outAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetOut, outAttribute, outUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetOut, outAttribute, outUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	prioritySchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       priorityAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetPriority, priorityAttribute, priorityUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetPriority, priorityAttribute, priorityUCIOption),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	tosSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tosAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetTOS, tosAttribute, tosUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetTOS, tosAttribute, tosUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 255),
+		},
+	}
+)
+
+// AddressFamily holds everything that differs between IPv4 and IPv6 rules.
+type AddressFamily struct {
+	AddressValidators        []validator.String
+	DestAttributeDescription string
+	SchemaDescription        string
+	SrcAttributeDescription  string
+	UCIType                  string
+}
+
+func NewDataSource(family AddressFamily) datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes(family),
+		family.SchemaDescription,
+		uciConfig,
+		family.UCIType,
+	)
+}
+
+func NewResource(family AddressFamily) resource.Resource {
+	return lucirpcglue.NewResource(
+		configValidators,
+		modelGetId,
+		schemaAttributes(family),
+		family.SchemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		family.UCIType,
+	)
+}
+
+type model struct {
+	Action       types.String `tfsdk:"action"`
+	Dest         types.String `tfsdk:"dest"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Goto         types.Int64  `tfsdk:"goto"`
+	Id           types.String `tfsdk:"id"`
+	In           types.String `tfsdk:"in"`
+	Invert       types.Bool   `tfsdk:"invert"`
+	Lookup       types.String `tfsdk:"lookup"`
+	Mark         types.String `tfsdk:"mark"`
+	Out          types.String `tfsdk:"out"`
+	Priority     types.Int64  `tfsdk:"priority"`
+	Src          types.String `tfsdk:"src"`
+	TOS          types.Int64  `tfsdk:"tos"`
+}
+
+func modelGetAction(m model) types.String    { return m.Action }
+func modelGetDest(m model) types.String      { return m.Dest }
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetGoto(m model) types.Int64       { return m.Goto }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetIn(m model) types.String        { return m.In }
+func modelGetInvert(m model) types.Bool      { return m.Invert }
+func modelGetLookup(m model) types.String    { return m.Lookup }
+func modelGetMark(m model) types.String      { return m.Mark }
+func modelGetOut(m model) types.String       { return m.Out }
+func modelGetPriority(m model) types.Int64   { return m.Priority }
+func modelGetSrc(m model) types.String       { return m.Src }
+func modelGetTOS(m model) types.Int64        { return m.TOS }
+
+func modelSetAction(m *model, value types.String)    { m.Action = value }
+func modelSetDest(m *model, value types.String)      { m.Dest = value }
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetGoto(m *model, value types.Int64)       { m.Goto = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetIn(m *model, value types.String)        { m.In = value }
+func modelSetInvert(m *model, value types.Bool)      { m.Invert = value }
+func modelSetLookup(m *model, value types.String)    { m.Lookup = value }
+func modelSetMark(m *model, value types.String)      { m.Mark = value }
+func modelSetOut(m *model, value types.String)       { m.Out = value }
+func modelSetPriority(m *model, value types.Int64)   { m.Priority = value }
+func modelSetSrc(m *model, value types.String)       { m.Src = value }
+func modelSetTOS(m *model, value types.Int64)        { m.TOS = value }
+
+func schemaAttributes(
+	family AddressFamily,
+) map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options] {
+	return map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		actionAttribute: actionSchemaAttribute,
+		destAttribute: lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+			Description:       family.DestAttributeDescription,
+			ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDest, destAttribute, destUCIOption),
+			ResourceExistence: lucirpcglue.NoValidation,
+			UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDest, destAttribute, destUCIOption),
+			Validators:        family.AddressValidators,
+		},
+		gotoAttribute:                     gotoSchemaAttribute,
+		inAttribute:                       inSchemaAttribute,
+		invertAttribute:                   invertSchemaAttribute,
+		lookupAttribute:                   lookupSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		markAttribute:                     markSchemaAttribute,
+		outAttribute:                      outSchemaAttribute,
+		priorityAttribute:                 prioritySchemaAttribute,
+		srcAttribute: lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+			Description:       family.SrcAttributeDescription,
+			ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSrc, srcAttribute, srcUCIOption),
+			ResourceExistence: lucirpcglue.NoValidation,
+			UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSrc, srcAttribute, srcUCIOption),
+			Validators:        family.AddressValidators,
+		},
+		tosAttribute: tosSchemaAttribute,
+	}
+}
@@ -0,0 +1,250 @@
+// Package staticroute defines the schema shared by IPv4 (`route`) and IPv6 (`route6`) static routes.
+//
+// The two only differ in the addresses and MTUs they accept,
+// so everything that depends on the address family is passed in as an [AddressFamily].
+package staticroute
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	gatewayAttribute = "gateway"
+	gatewayUCIOption = "gateway"
+
+	interfaceAttribute            = "interface"
+	interfaceAttributeDescription = "Name of the logical interface the route belongs to. This is the `id` of an `openwrt_network_interface`."
+	interfaceUCIOption            = "interface"
+
+	metricAttribute            = "metric"
+	metricAttributeDescription = "Metric of the route. Lower metrics are preferred."
+	metricUCIOption            = "metric"
+
+	mtuAttribute = "mtu"
+	mtuUCIOption = "mtu"
+
+	onlinkAttribute            = "onlink"
+	onlinkAttributeDescription = "Treat the gateway as directly reachable through the interface, even if it isn't in the interface's subnet. Defaults to `false`."
+	onlinkDefaultValue         = false
+	onlinkUCIOption            = "onlink"
+
+	schemaVersion = 0
+
+	sourceAttribute = "source"
+	sourceUCIOption = "source"
+
+	tableAttribute            = "table"
+	tableAttributeDescription = `Routing table to add the route to, by name or number (e.g. "main", "100"). If unset, "main" is used.`
+	tableUCIOption            = "table"
+
+	targetAttribute = "target"
+	targetUCIOption = "target"
+
+	typeAttribute            = "type"
+	typeAttributeDescription = `The kind of route. Must be one of: "anycast", "blackhole", "broadcast", "local", "multicast", "prohibit", "throw", "unicast", "unreachable". Defaults to "unicast".`
+	typeAnycast              = "anycast"
+	typeBlackhole            = "blackhole"
+	typeBroadcast            = "broadcast"
+	typeDefaultValue         = typeUnicast
+	typeLocal                = "local"
+	typeMulticast            = "multicast"
+	typeProhibit             = "prohibit"
+	typeThrow                = "throw"
+	typeUCIOption            = "type"
+	typeUnicast              = "unicast"
+	typeUnreachable          = "unreachable"
+
+	uciConfig = "network"
+)
+
+var (
+	interfaceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       interfaceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetInterface, interfaceAttribute, interfaceUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetInterface, interfaceAttribute, interfaceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	metricSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       metricAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetMetric, metricAttribute, metricUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetMetric, metricAttribute, metricUCIOption),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+
+	onlinkSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(onlinkDefaultValue),
+		Description:       onlinkAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetOnlink, onlinkAttribute, onlinkUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetOnlink, onlinkAttribute, onlinkUCIOption),
+	}
+
+	tableSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tableAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTable, tableAttribute, tableUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTable, tableAttribute, tableUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:alnum:]_-]+$"),
+				`must be a routing table name or number (e.g. "main", "100")`,
+			),
+		},
+	}
+
+	typeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(typeDefaultValue),
+		Description:       typeAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetType, typeAttribute, typeUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetType, typeAttribute, typeUCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				typeAnycast,
+				typeBlackhole,
+				typeBroadcast,
+				typeLocal,
+				typeMulticast,
+				typeProhibit,
+				typeThrow,
+				typeUnicast,
+				typeUnreachable,
+			),
+		},
+	}
+)
+
+// AddressFamily holds everything that differs between IPv4 and IPv6 routes.
+type AddressFamily struct {
+	GatewayAttributeDescription string
+	GatewayValidators           []validator.String
+	MTUAttributeDescription     string
+	MTUValidators               []validator.Int64
+	SchemaDescription           string
+	SourceAttributeDescription  string
+	SourceValidators            []validator.String
+	TargetAttributeDescription  string
+	TargetValidators            []validator.String
+	UCIType                     string
+}
+
+func NewDataSource(family AddressFamily) datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes(family),
+		family.SchemaDescription,
+		uciConfig,
+		family.UCIType,
+	)
+}
+
+func NewResource(family AddressFamily) resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes(family),
+		family.SchemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		family.UCIType,
+	)
+}
+
+type model struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Gateway      types.String `tfsdk:"gateway"`
+	Id           types.String `tfsdk:"id"`
+	Interface    types.String `tfsdk:"interface"`
+	Metric       types.Int64  `tfsdk:"metric"`
+	MTU          types.Int64  `tfsdk:"mtu"`
+	Onlink       types.Bool   `tfsdk:"onlink"`
+	Source       types.String `tfsdk:"source"`
+	Table        types.String `tfsdk:"table"`
+	Target       types.String `tfsdk:"target"`
+	Type         types.String `tfsdk:"type"`
+}
+
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetGateway(m model) types.String   { return m.Gateway }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetInterface(m model) types.String { return m.Interface }
+func modelGetMetric(m model) types.Int64     { return m.Metric }
+func modelGetMTU(m model) types.Int64        { return m.MTU }
+func modelGetOnlink(m model) types.Bool      { return m.Onlink }
+func modelGetSource(m model) types.String    { return m.Source }
+func modelGetTable(m model) types.String     { return m.Table }
+func modelGetTarget(m model) types.String    { return m.Target }
+func modelGetType(m model) types.String      { return m.Type }
+
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetGateway(m *model, value types.String)   { m.Gateway = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetInterface(m *model, value types.String) { m.Interface = value }
+func modelSetMetric(m *model, value types.Int64)     { m.Metric = value }
+func modelSetMTU(m *model, value types.Int64)        { m.MTU = value }
+func modelSetOnlink(m *model, value types.Bool)      { m.Onlink = value }
+func modelSetSource(m *model, value types.String)    { m.Source = value }
+func modelSetTable(m *model, value types.String)     { m.Table = value }
+func modelSetTarget(m *model, value types.String)    { m.Target = value }
+func modelSetType(m *model, value types.String)      { m.Type = value }
+
+func schemaAttributes(
+	family AddressFamily,
+) map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options] {
+	return map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		gatewayAttribute: lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+			Description:       family.GatewayAttributeDescription,
+			ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetGateway, gatewayAttribute, gatewayUCIOption),
+			ResourceExistence: lucirpcglue.NoValidation,
+			UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetGateway, gatewayAttribute, gatewayUCIOption),
+			Validators:        family.GatewayValidators,
+		},
+		interfaceAttribute:                interfaceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		metricAttribute:                   metricSchemaAttribute,
+		mtuAttribute: lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+			Description:       family.MTUAttributeDescription,
+			ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetMTU, mtuAttribute, mtuUCIOption),
+			ResourceExistence: lucirpcglue.NoValidation,
+			UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetMTU, mtuAttribute, mtuUCIOption),
+			Validators:        family.MTUValidators,
+		},
+		onlinkAttribute: onlinkSchemaAttribute,
+		sourceAttribute: lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+			Description:       family.SourceAttributeDescription,
+			ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSource, sourceAttribute, sourceUCIOption),
+			ResourceExistence: lucirpcglue.NoValidation,
+			UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSource, sourceAttribute, sourceUCIOption),
+			Validators:        family.SourceValidators,
+		},
+		tableAttribute: tableSchemaAttribute,
+		targetAttribute: lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+			Description:       family.TargetAttributeDescription,
+			ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTarget, targetAttribute, targetUCIOption),
+			ResourceExistence: lucirpcglue.Required,
+			UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTarget, targetAttribute, targetUCIOption),
+			Validators:        family.TargetValidators,
+		},
+		typeAttribute: typeSchemaAttribute,
+	}
+}
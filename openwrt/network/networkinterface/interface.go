@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
@@ -16,16 +17,26 @@ import (
 )
 
 const (
+	accessConcentratorAttribute            = "ac"
+	accessConcentratorAttributeDescription = "Access concentrator to connect to. If unset, the first one to respond is used."
+	accessConcentratorUCIOption            = "ac"
+
+	addressesAttribute            = "addresses"
+	addressesAttributeDescription = `Addresses of the WireGuard interface in CIDR notation (e.g. "10.0.0.1/24"). Only used when "proto" is "wireguard".`
+	addressesUCIOption            = "addresses"
+
 	bringUpOnBootAttribute            = "auto"
-	bringUpOnBootAttributeDescription = "Specifies whether to bring up this interface on boot."
+	bringUpOnBootAttributeDescription = "Specifies whether to bring up this interface on boot. Defaults to `true`."
+	bringUpOnBootDefaultValue         = true
 	bringUpOnBootUCIOption            = "auto"
 
 	deviceAttribute            = "device"
-	deviceAttributeDescription = "Name of the (physical or virtual) device. This name is what the device is known as in LuCI or the `name` field in Terraform. This is not the UCI config name."
+	deviceAttributeDescription = "Name of the (physical or virtual) device. This name is what the device is known as in LuCI or the `name` field in Terraform. This is not the UCI config name. Required unless `proto` is \"wireguard\", which creates its own device."
 	deviceUCIOption            = "device"
 
 	disabledAttribute            = "disabled"
-	disabledAttributeDescription = "Disables this interface."
+	disabledAttributeDescription = "Disables this interface. Defaults to `false`."
+	disabledDefaultValue         = false
 	disabledUCIOption            = "disabled"
 
 	dnsAttribute            = "dns"
@@ -36,14 +47,49 @@ const (
 	gatewayAttributeDescription = "Gateway of the interface"
 	gatewayUCIOption            = "gateway"
 
+	inputKeyAttribute            = "ikey"
+	inputKeyAttributeDescription = "Key of incoming GRE packets. Must be in the range: `[0, 4294967295]`."
+	inputKeyUCIOption            = "ikey"
+
+	ip4PrefixLengthAttribute            = "ip4prefixlen"
+	ip4PrefixLengthAttributeDescription = "Number of leading bits of the IPv4 address shared by all 6rd customers of the ISP. Must be in the range: `[0, 32]`. If unset, `0` is used."
+	ip4PrefixLengthUCIOption            = "ip4prefixlen"
+
+	ip6AddressAttribute            = "ip6addr"
+	ip6AddressAttributeDescription = `IPv6 address of the interface in CIDR notation (e.g. "2001:db8:1::2/64"). For a "6in4" tunnel, this is the local address of the tunnel. Only used with the "6in4" and "static" protocols.`
+	ip6AddressUCIOption            = "ip6addr"
+
 	ip6AssignAttribute            = "ip6assign"
 	ip6AssignAttributeDescription = "Delegate a prefix of given length to this interface"
 	ip6AssignUCIOption            = "ip6assign"
 
+	ip6PrefixAttribute            = "ip6prefix"
+	ip6PrefixAttributeDescription = `For "6in4", the prefix routed through the tunnel (e.g. "2001:db8:2::/48"). For "6rd", the 6rd prefix of the ISP (e.g. "2001:db8::").`
+	ip6PrefixUCIOption            = "ip6prefix"
+
+	ip6PrefixLengthAttribute            = "ip6prefixlen"
+	ip6PrefixLengthAttributeDescription = "Length of the 6rd prefix. Must be in the range: `[0, 128]`."
+	ip6PrefixLengthUCIOption            = "ip6prefixlen"
+
 	ipAddressAttribute            = "ipaddr"
 	ipAddressAttributeDescription = "IP address of the interface"
 	ipAddressUCIOption            = "ipaddr"
 
+	ipv6Attribute            = "ipv6"
+	ipv6AttributeDescription = `Negotiate IPv6 on the PPP link. Must be one of: "0", "1", "auto". With "auto", a DHCPv6 client is also started on the link. If unset, "auto" is used.`
+	ipv6Auto                 = "auto"
+	ipv6Disabled             = "0"
+	ipv6Enabled              = "1"
+	ipv6UCIOption            = "ipv6"
+
+	keepaliveAttribute            = "keepalive"
+	keepaliveAttributeDescription = `Number of failed LCP echo requests before the link is considered down, optionally followed by the seconds between requests (e.g. "5 1").`
+	keepaliveUCIOption            = "keepalive"
+
+	listenPortAttribute            = "listen_port"
+	listenPortAttributeDescription = "Port the WireGuard interface listens on. Must be in the range: `[1, 65535]`. If unset, a random port is used."
+	listenPortUCIOption            = "listen_port"
+
 	macAddressAttribute            = "macaddr"
 	macAddressAttributeDescription = "Override the MAC Address of this interface."
 	macAddressUCIOption            = "macaddr"
@@ -56,16 +102,37 @@ const (
 	netmaskAttributeDescription = "Netmask of the interface"
 	netmaskUCIOption            = "netmask"
 
+	outputKeyAttribute            = "okey"
+	outputKeyAttributeDescription = "Key of outgoing GRE packets. Must be in the range: `[0, 4294967295]`."
+	outputKeyUCIOption            = "okey"
+
+	passwordAttribute            = "password"
+	passwordAttributeDescription = `Password for PPPoE, or for updating the endpoint of a "6in4" tunnel at the tunnel broker.`
+	passwordUCIOption            = "password"
+
+	peerAddressAttribute            = "peeraddr"
+	peerAddressAttributeDescription = `IPv4 address of the remote end of the tunnel (e.g. "192.0.2.1"). Required for "6in4", "6rd", and "gre".`
+	peerAddressUCIOption            = "peeraddr"
+
 	peerDNSAttribute            = "peerdns"
 	peerDNSAttributeDescription = "Use DHCP-provided DNS servers."
 	peerDNSUCIOption            = "peerdns"
 
 	protocolAttribute            = "proto"
-	protocolAttributeDescription = `The protocol type of the interface. Currently, only "dhcp, and "static" are supported.`
+	protocolAttributeDescription = `The protocol type of the interface. Must be one of: "6in4", "6rd", "dhcp", "dhcpv6", "gre", "pppoe", "static", "wireguard".`
+	protocol6In4                 = "6in4"
+	protocol6RD                  = "6rd"
 	protocolDHCP                 = "dhcp"
 	protocolDHCPV6               = "dhcpv6"
+	protocolGRE                  = "gre"
+	protocolPPPoE                = "pppoe"
 	protocolStatic               = "static"
 	protocolUCIOption            = "proto"
+	protocolWireGuard            = "wireguard"
+
+	privateKeyAttribute            = "private_key"
+	privateKeyAttributeDescription = "Base64-encoded private key of the WireGuard interface (e.g. the output of `wg genkey`). Required when `proto` is \"wireguard\"."
+	privateKeyUCIOption            = "private_key"
 
 	requestingAddressAttribute            = "reqaddress"
 	requestingAddressAttributeDescription = `Behavior for requesting address. Can only be one of "force", "try", or "none".`
@@ -83,27 +150,165 @@ const (
 	requestingPrefixUCIOption            = "reqprefix"
 
 	schemaDescription = "A logic network."
+	schemaVersion     = 0
+
+	serviceAttribute            = "service"
+	serviceAttributeDescription = "Service name to connect to. If unset, any service is accepted."
+	serviceUCIOption            = "service"
+
+	tosAttribute            = "tos"
+	tosAttributeDescription = `Type of service of the outer packets. Either "inherit" or a hex value (e.g. "10").`
+	tosUCIOption            = "tos"
+
+	ttlAttribute            = "ttl"
+	ttlAttributeDescription = "Time to live of the outer packets. Must be in the range: `[1, 255]`."
+	ttlUCIOption            = "ttl"
+
+	tunnelIdAttribute            = "tunnelid"
+	tunnelIdAttributeDescription = "Tunnel id at the tunnel broker. Used with `username` and `password` to update the endpoint of the tunnel."
+	tunnelIdUCIOption            = "tunnelid"
+
+	usernameAttribute            = "username"
+	usernameAttributeDescription = `Username for PPPoE, or for updating the endpoint of a "6in4" tunnel at the tunnel broker.`
+	usernameUCIOption            = "username"
 
 	uciConfig = "network"
 	uciType   = "interface"
 )
 
 var (
+	accessConcentratorSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       accessConcentratorAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetAccessConcentrator, accessConcentratorAttribute, accessConcentratorUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetAccessConcentrator, accessConcentratorAttribute, accessConcentratorUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolPPPoE,
+			),
+		},
+	}
+
+	addressesSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       addressesAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetAddresses, addressesAttribute, addressesUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetAddresses, addressesAttribute, addressesUCIOption),
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[[:xdigit:].:]+(/[[:digit:]]{1,3})?$"),
+					`must be a valid address in CIDR notation (e.g. "10.0.0.1/24")`,
+				),
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolWireGuard,
+			),
+		},
+	}
+
 	bringUpOnBootSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(bringUpOnBootDefaultValue),
 		Description:       bringUpOnBootAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetBringUpOnBoot, bringUpOnBootAttribute, bringUpOnBootUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetBringUpOnBoot, bringUpOnBootAttribute, bringUpOnBootUCIOption),
 	}
 
+	configValidators = []resource.ConfigValidator{
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocol6In4,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(peerAddressAttribute),
+			),
+			lucirpcglue.NoneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocol6RD,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(peerAddressAttribute),
+			),
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(ip6PrefixAttribute),
+			),
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(ip6PrefixLengthAttribute),
+			),
+			lucirpcglue.NoneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocolDHCP,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocolDHCPV6,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocolGRE,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(peerAddressAttribute),
+			),
+			lucirpcglue.NoneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocolPPPoE,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocolStatic,
+			lucirpcglue.AllOrNone(
+				path.MatchRoot(ipAddressAttribute),
+				path.MatchRoot(netmaskAttribute),
+			),
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(protocolAttribute),
+			protocolWireGuard,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(privateKeyAttribute),
+			),
+			lucirpcglue.NoneOf(
+				path.MatchRoot(deviceAttribute),
+			),
+		),
+	}
+
 	deviceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       deviceAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDevice, deviceAttribute, deviceUCIOption),
-		ResourceExistence: lucirpcglue.Required,
+		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDevice, deviceAttribute, deviceUCIOption),
 	}
 
 	disabledSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(disabledDefaultValue),
 		Description:       disabledAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetDisabled, disabledAttribute, disabledUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -126,6 +331,10 @@ var (
 					path.MatchRoot(protocolAttribute),
 					protocolDHCPV6,
 				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolPPPoE,
+				),
 				lucirpcglue.RequiresAttributeEqualString(
 					path.MatchRoot(protocolAttribute),
 					protocolStatic,
@@ -151,6 +360,57 @@ var (
 		},
 	}
 
+	inputKeySchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       inputKeyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetInputKey, inputKeyAttribute, inputKeyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetInputKey, inputKeyAttribute, inputKeyUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 4294967295),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolGRE,
+			),
+		},
+	}
+
+	ip4PrefixLengthSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ip4PrefixLengthAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetIP4PrefixLength, ip4PrefixLengthAttribute, ip4PrefixLengthUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetIP4PrefixLength, ip4PrefixLengthAttribute, ip4PrefixLengthUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 32),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocol6RD,
+			),
+		},
+	}
+
+	ip6AddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ip6AddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIP6Address, ip6AddressAttribute, ip6AddressUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetIP6Address, ip6AddressAttribute, ip6AddressUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:xdigit:]]{0,4}:){2,7}[[:xdigit:]]{0,4}(/(12[0-8]|1[01][[:digit:]]|[1-9]?[[:digit:]]))?$"),
+				`must be a valid IPv6 address or CIDR (e.g. "2001:db8:1::2/64")`,
+			),
+			stringvalidator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolStatic,
+				),
+			),
+		},
+	}
+
 	ip6AssignSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       ip6AssignAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetIP6Assign, ip6AssignAttribute, ip6AssignUCIOption),
@@ -165,6 +425,43 @@ var (
 		},
 	}
 
+	ip6PrefixSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ip6PrefixAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIP6Prefix, ip6PrefixAttribute, ip6PrefixUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetIP6Prefix, ip6PrefixAttribute, ip6PrefixUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:xdigit:]]{0,4}:){2,7}[[:xdigit:]]{0,4}(/(12[0-8]|1[01][[:digit:]]|[1-9]?[[:digit:]]))?$"),
+				`must be a valid IPv6 address or CIDR (e.g. "2001:db8:2::/48")`,
+			),
+			stringvalidator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6RD,
+				),
+			),
+		},
+	}
+
+	ip6PrefixLengthSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ip6PrefixLengthAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetIP6PrefixLength, ip6PrefixLengthAttribute, ip6PrefixLengthUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetIP6PrefixLength, ip6PrefixLengthAttribute, ip6PrefixLengthUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 128),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocol6RD,
+			),
+		},
+	}
+
 	ipAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       ipAddressAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIPAddress, ipAddressAttribute, ipAddressUCIOption),
@@ -178,6 +475,55 @@ var (
 		},
 	}
 
+	ipv6SchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ipv6AttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIPv6, ipv6Attribute, ipv6UCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetIPv6, ipv6Attribute, ipv6UCIOption),
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				ipv6Auto,
+				ipv6Disabled,
+				ipv6Enabled,
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolPPPoE,
+			),
+		},
+	}
+
+	keepaliveSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       keepaliveAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetKeepalive, keepaliveAttribute, keepaliveUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetKeepalive, keepaliveAttribute, keepaliveUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:digit:]]+( [[:digit:]]+)?$"),
+				`must be a number of failures, optionally followed by an interval (e.g. "5 1")`,
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolPPPoE,
+			),
+		},
+	}
+
+	listenPortSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       listenPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetListenPort, listenPortAttribute, listenPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetListenPort, listenPortAttribute, listenPortUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolWireGuard,
+			),
+		},
+	}
+
 	macAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       macAddressAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMacAddress, macAddressAttribute, macAddressUCIOption),
@@ -218,6 +564,68 @@ var (
 		},
 	}
 
+	outputKeySchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       outputKeyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetOutputKey, outputKeyAttribute, outputKeyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetOutputKey, outputKeyAttribute, outputKeyUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 4294967295),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolGRE,
+			),
+		},
+	}
+
+	passwordSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       passwordAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPassword, passwordAttribute, passwordUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		Sensitive:         true,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPassword, passwordAttribute, passwordUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolPPPoE,
+				),
+			),
+		},
+	}
+
+	peerAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       peerAddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPeerAddress, peerAddressAttribute, peerAddressUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPeerAddress, peerAddressAttribute, peerAddressUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:digit:]]{1,3}\\.){3}[[:digit:]]{1,3}$"),
+				`must be a valid IPv4 address (e.g. "192.0.2.1")`,
+			),
+			stringvalidator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6RD,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolGRE,
+				),
+			),
+		},
+	}
+
 	peerDNSSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       peerDNSAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetPeerDNS, peerDNSAttribute, peerDNSUCIOption),
@@ -233,6 +641,28 @@ var (
 					path.MatchRoot(protocolAttribute),
 					protocolDHCPV6,
 				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolPPPoE,
+				),
+			),
+		},
+	}
+
+	privateKeySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       privateKeyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPrivateKey, privateKeyAttribute, privateKeyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		Sensitive:         true,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPrivateKey, privateKeyAttribute, privateKeyUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:alnum:]+/]{43}=$"),
+				"must be a base64-encoded WireGuard key",
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolWireGuard,
 			),
 		},
 	}
@@ -244,9 +674,14 @@ var (
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetProtocol, protocolAttribute, protocolUCIOption),
 		Validators: []validator.String{
 			stringvalidator.OneOf(
+				protocol6In4,
+				protocol6RD,
 				protocolDHCP,
 				protocolDHCPV6,
+				protocolGRE,
+				protocolPPPoE,
 				protocolStatic,
+				protocolWireGuard,
 			),
 		},
 	}
@@ -286,21 +721,143 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		bringUpOnBootAttribute:     bringUpOnBootSchemaAttribute,
-		deviceAttribute:            deviceSchemaAttribute,
-		disabledAttribute:          disabledSchemaAttribute,
-		dnsAttribute:               dnsSchemaAttribute,
-		gatewayAttribute:           gatewaySchemaAttribute,
-		ip6AssignAttribute:         ip6AssignSchemaAttribute,
-		ipAddressAttribute:         ipAddressSchemaAttribute,
-		macAddressAttribute:        macAddressSchemaAttribute,
-		mtuAttribute:               mtuSchemaAttribute,
-		netmaskAttribute:           netmaskSchemaAttribute,
-		peerDNSAttribute:           peerDNSSchemaAttribute,
-		protocolAttribute:          protocolSchemaAttribute,
-		requestingAddressAttribute: requestingAddressSchemaAttribute,
-		requestingPrefixAttribute:  requestingPrefixSchemaAttribute,
-		lucirpcglue.IdAttribute:    lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		accessConcentratorAttribute:       accessConcentratorSchemaAttribute,
+		addressesAttribute:                addressesSchemaAttribute,
+		bringUpOnBootAttribute:            bringUpOnBootSchemaAttribute,
+		deviceAttribute:                   deviceSchemaAttribute,
+		disabledAttribute:                 disabledSchemaAttribute,
+		dnsAttribute:                      dnsSchemaAttribute,
+		gatewayAttribute:                  gatewaySchemaAttribute,
+		inputKeyAttribute:                 inputKeySchemaAttribute,
+		ip4PrefixLengthAttribute:          ip4PrefixLengthSchemaAttribute,
+		ip6AddressAttribute:               ip6AddressSchemaAttribute,
+		ip6AssignAttribute:                ip6AssignSchemaAttribute,
+		ip6PrefixAttribute:                ip6PrefixSchemaAttribute,
+		ip6PrefixLengthAttribute:          ip6PrefixLengthSchemaAttribute,
+		ipAddressAttribute:                ipAddressSchemaAttribute,
+		ipv6Attribute:                     ipv6SchemaAttribute,
+		keepaliveAttribute:                keepaliveSchemaAttribute,
+		listenPortAttribute:               listenPortSchemaAttribute,
+		macAddressAttribute:               macAddressSchemaAttribute,
+		mtuAttribute:                      mtuSchemaAttribute,
+		netmaskAttribute:                  netmaskSchemaAttribute,
+		outputKeyAttribute:                outputKeySchemaAttribute,
+		passwordAttribute:                 passwordSchemaAttribute,
+		peerAddressAttribute:              peerAddressSchemaAttribute,
+		peerDNSAttribute:                  peerDNSSchemaAttribute,
+		privateKeyAttribute:               privateKeySchemaAttribute,
+		protocolAttribute:                 protocolSchemaAttribute,
+		requestingAddressAttribute:        requestingAddressSchemaAttribute,
+		requestingPrefixAttribute:         requestingPrefixSchemaAttribute,
+		serviceAttribute:                  serviceSchemaAttribute,
+		tosAttribute:                      tosSchemaAttribute,
+		ttlAttribute:                      ttlSchemaAttribute,
+		tunnelIdAttribute:                 tunnelIdSchemaAttribute,
+		usernameAttribute:                 usernameSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+	}
+
+	serviceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       serviceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetService, serviceAttribute, serviceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetService, serviceAttribute, serviceUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocolPPPoE,
+			),
+		},
+	}
+
+	tosSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tosAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTOS, tosAttribute, tosUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTOS, tosAttribute, tosUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^(inherit|[[:xdigit:]]{1,2})$"),
+				`must be "inherit" or a hex value (e.g. "10")`,
+			),
+			stringvalidator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6RD,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolGRE,
+				),
+			),
+		},
+	}
+
+	ttlSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ttlAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetTTL, ttlAttribute, ttlUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetTTL, ttlAttribute, ttlUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 255),
+			int64validator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6RD,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolGRE,
+				),
+			),
+		},
+	}
+
+	tunnelIdSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       tunnelIdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetTunnelId, tunnelIdAttribute, tunnelIdUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetTunnelId, tunnelIdAttribute, tunnelIdUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:digit:]]+$"),
+				"must be a number",
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(protocolAttribute),
+				protocol6In4,
+			),
+		},
+	}
+
+	usernameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       usernameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetUsername, usernameAttribute, usernameUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetUsername, usernameAttribute, usernameUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocol6In4,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(protocolAttribute),
+					protocolPPPoE,
+				),
+			),
+		},
 	}
 )
 
@@ -316,60 +873,123 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		configValidators,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
-	BringUpOnBoot     types.Bool   `tfsdk:"auto"`
-	Device            types.String `tfsdk:"device"`
-	Disabled          types.Bool   `tfsdk:"disabled"`
-	DNS               types.List   `tfsdk:"dns"`
-	Gateway           types.String `tfsdk:"gateway"`
-	Id                types.String `tfsdk:"id"`
-	IP6Assign         types.Int64  `tfsdk:"ip6assign"`
-	IPAddress         types.String `tfsdk:"ipaddr"`
-	MacAddress        types.String `tfsdk:"macaddr"`
-	MTU               types.Int64  `tfsdk:"mtu"`
-	Netmask           types.String `tfsdk:"netmask"`
-	PeerDNS           types.Bool   `tfsdk:"peerdns"`
-	Protocol          types.String `tfsdk:"proto"`
-	RequestingAddress types.String `tfsdk:"reqaddress"`
-	RequestingPrefix  types.String `tfsdk:"reqprefix"`
+	AccessConcentrator types.String `tfsdk:"ac"`
+	Addresses          types.List   `tfsdk:"addresses"`
+	BringUpOnBoot      types.Bool   `tfsdk:"auto"`
+	Device             types.String `tfsdk:"device"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	DNS                types.List   `tfsdk:"dns"`
+	ExtraOptions       types.Map    `tfsdk:"extra_options"`
+	Gateway            types.String `tfsdk:"gateway"`
+	Id                 types.String `tfsdk:"id"`
+	InputKey           types.Int64  `tfsdk:"ikey"`
+	IP4PrefixLength    types.Int64  `tfsdk:"ip4prefixlen"`
+	IP6Address         types.String `tfsdk:"ip6addr"`
+	IP6Assign          types.Int64  `tfsdk:"ip6assign"`
+	IP6Prefix          types.String `tfsdk:"ip6prefix"`
+	IP6PrefixLength    types.Int64  `tfsdk:"ip6prefixlen"`
+	IPAddress          types.String `tfsdk:"ipaddr"`
+	IPv6               types.String `tfsdk:"ipv6"`
+	Keepalive          types.String `tfsdk:"keepalive"`
+	ListenPort         types.Int64  `tfsdk:"listen_port"`
+	MacAddress         types.String `tfsdk:"macaddr"`
+	MTU                types.Int64  `tfsdk:"mtu"`
+	Netmask            types.String `tfsdk:"netmask"`
+	OutputKey          types.Int64  `tfsdk:"okey"`
+	Password           types.String `tfsdk:"password"`
+	PeerAddress        types.String `tfsdk:"peeraddr"`
+	PeerDNS            types.Bool   `tfsdk:"peerdns"`
+	PrivateKey         types.String `tfsdk:"private_key"`
+	Protocol           types.String `tfsdk:"proto"`
+	RequestingAddress  types.String `tfsdk:"reqaddress"`
+	RequestingPrefix   types.String `tfsdk:"reqprefix"`
+	Service            types.String `tfsdk:"service"`
+	TOS                types.String `tfsdk:"tos"`
+	TTL                types.Int64  `tfsdk:"ttl"`
+	TunnelId           types.String `tfsdk:"tunnelid"`
+	Username           types.String `tfsdk:"username"`
 }
 
-func modelGetBringUpOnBoot(m model) types.Bool       { return m.BringUpOnBoot }
-func modelGetDevice(m model) types.String            { return m.Device }
-func modelGetDisabled(m model) types.Bool            { return m.Disabled }
-func modelGetDNS(m model) types.List                 { return m.DNS }
-func modelGetGateway(m model) types.String           { return m.Gateway }
-func modelGetId(m model) types.String                { return m.Id }
-func modelGetIP6Assign(m model) types.Int64          { return m.IP6Assign }
-func modelGetIPAddress(m model) types.String         { return m.IPAddress }
-func modelGetMacAddress(m model) types.String        { return m.MacAddress }
-func modelGetMTU(m model) types.Int64                { return m.MTU }
-func modelGetNetmask(m model) types.String           { return m.Netmask }
-func modelGetPeerDNS(m model) types.Bool             { return m.PeerDNS }
-func modelGetProtocol(m model) types.String          { return m.Protocol }
-func modelGetRequestingAddress(m model) types.String { return m.RequestingAddress }
-func modelGetRequestingPrefix(m model) types.String  { return m.RequestingPrefix }
-
-func modelSetBringUpOnBoot(m *model, value types.Bool)       { m.BringUpOnBoot = value }
-func modelSetDevice(m *model, value types.String)            { m.Device = value }
-func modelSetDisabled(m *model, value types.Bool)            { m.Disabled = value }
-func modelSetDNS(m *model, value types.List)                 { m.DNS = value }
-func modelSetGateway(m *model, value types.String)           { m.Gateway = value }
-func modelSetId(m *model, value types.String)                { m.Id = value }
-func modelSetIP6Assign(m *model, value types.Int64)          { m.IP6Assign = value }
-func modelSetIPAddress(m *model, value types.String)         { m.IPAddress = value }
-func modelSetMacAddress(m *model, value types.String)        { m.MacAddress = value }
-func modelSetMTU(m *model, value types.Int64)                { m.MTU = value }
-func modelSetNetmask(m *model, value types.String)           { m.Netmask = value }
-func modelSetPeerDNS(m *model, value types.Bool)             { m.PeerDNS = value }
-func modelSetProtocol(m *model, value types.String)          { m.Protocol = value }
-func modelSetRequestingAddress(m *model, value types.String) { m.RequestingAddress = value }
-func modelSetRequestingPrefix(m *model, value types.String)  { m.RequestingPrefix = value }
+func modelGetAccessConcentrator(m model) types.String { return m.AccessConcentrator }
+func modelGetAddresses(m model) types.List            { return m.Addresses }
+func modelGetBringUpOnBoot(m model) types.Bool        { return m.BringUpOnBoot }
+func modelGetDevice(m model) types.String             { return m.Device }
+func modelGetDisabled(m model) types.Bool             { return m.Disabled }
+func modelGetDNS(m model) types.List                  { return m.DNS }
+func modelGetExtraOptions(m model) types.Map          { return m.ExtraOptions }
+func modelGetGateway(m model) types.String            { return m.Gateway }
+func modelGetId(m model) types.String                 { return m.Id }
+func modelGetInputKey(m model) types.Int64            { return m.InputKey }
+func modelGetIP4PrefixLength(m model) types.Int64     { return m.IP4PrefixLength }
+func modelGetIP6Address(m model) types.String         { return m.IP6Address }
+func modelGetIP6Assign(m model) types.Int64           { return m.IP6Assign }
+func modelGetIP6Prefix(m model) types.String          { return m.IP6Prefix }
+func modelGetIP6PrefixLength(m model) types.Int64     { return m.IP6PrefixLength }
+func modelGetIPAddress(m model) types.String          { return m.IPAddress }
+func modelGetIPv6(m model) types.String               { return m.IPv6 }
+func modelGetKeepalive(m model) types.String          { return m.Keepalive }
+func modelGetListenPort(m model) types.Int64          { return m.ListenPort }
+func modelGetMacAddress(m model) types.String         { return m.MacAddress }
+func modelGetMTU(m model) types.Int64                 { return m.MTU }
+func modelGetNetmask(m model) types.String            { return m.Netmask }
+func modelGetOutputKey(m model) types.Int64           { return m.OutputKey }
+func modelGetPassword(m model) types.String           { return m.Password }
+func modelGetPeerAddress(m model) types.String        { return m.PeerAddress }
+func modelGetPeerDNS(m model) types.Bool              { return m.PeerDNS }
+func modelGetPrivateKey(m model) types.String         { return m.PrivateKey }
+func modelGetProtocol(m model) types.String           { return m.Protocol }
+func modelGetRequestingAddress(m model) types.String  { return m.RequestingAddress }
+func modelGetRequestingPrefix(m model) types.String   { return m.RequestingPrefix }
+func modelGetService(m model) types.String            { return m.Service }
+func modelGetTOS(m model) types.String                { return m.TOS }
+func modelGetTTL(m model) types.Int64                 { return m.TTL }
+func modelGetTunnelId(m model) types.String           { return m.TunnelId }
+func modelGetUsername(m model) types.String           { return m.Username }
+
+func modelSetAccessConcentrator(m *model, value types.String) { m.AccessConcentrator = value }
+func modelSetAddresses(m *model, value types.List)            { m.Addresses = value }
+func modelSetBringUpOnBoot(m *model, value types.Bool)        { m.BringUpOnBoot = value }
+func modelSetDevice(m *model, value types.String)             { m.Device = value }
+func modelSetDisabled(m *model, value types.Bool)             { m.Disabled = value }
+func modelSetDNS(m *model, value types.List)                  { m.DNS = value }
+func modelSetExtraOptions(m *model, value types.Map)          { m.ExtraOptions = value }
+func modelSetGateway(m *model, value types.String)            { m.Gateway = value }
+func modelSetId(m *model, value types.String)                 { m.Id = value }
+func modelSetInputKey(m *model, value types.Int64)            { m.InputKey = value }
+func modelSetIP4PrefixLength(m *model, value types.Int64)     { m.IP4PrefixLength = value }
+func modelSetIP6Address(m *model, value types.String)         { m.IP6Address = value }
+func modelSetIP6Assign(m *model, value types.Int64)           { m.IP6Assign = value }
+func modelSetIP6Prefix(m *model, value types.String)          { m.IP6Prefix = value }
+func modelSetIP6PrefixLength(m *model, value types.Int64)     { m.IP6PrefixLength = value }
+func modelSetIPAddress(m *model, value types.String)          { m.IPAddress = value }
+func modelSetIPv6(m *model, value types.String)               { m.IPv6 = value }
+func modelSetKeepalive(m *model, value types.String)          { m.Keepalive = value }
+func modelSetListenPort(m *model, value types.Int64)          { m.ListenPort = value }
+func modelSetMacAddress(m *model, value types.String)         { m.MacAddress = value }
+func modelSetMTU(m *model, value types.Int64)                 { m.MTU = value }
+func modelSetNetmask(m *model, value types.String)            { m.Netmask = value }
+func modelSetOutputKey(m *model, value types.Int64)           { m.OutputKey = value }
+func modelSetPassword(m *model, value types.String)           { m.Password = value }
+func modelSetPeerAddress(m *model, value types.String)        { m.PeerAddress = value }
+func modelSetPeerDNS(m *model, value types.Bool)              { m.PeerDNS = value }
+func modelSetPrivateKey(m *model, value types.String)         { m.PrivateKey = value }
+func modelSetProtocol(m *model, value types.String)           { m.Protocol = value }
+func modelSetRequestingAddress(m *model, value types.String)  { m.RequestingAddress = value }
+func modelSetRequestingPrefix(m *model, value types.String)   { m.RequestingPrefix = value }
+func modelSetService(m *model, value types.String)            { m.Service = value }
+func modelSetTOS(m *model, value types.String)                { m.TOS = value }
+func modelSetTTL(m *model, value types.Int64)                 { m.TTL = value }
+func modelSetTunnelId(m *model, value types.String)           { m.TunnelId = value }
+func modelSetUsername(m *model, value types.String)           { m.Username = value }
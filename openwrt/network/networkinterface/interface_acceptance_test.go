@@ -5,6 +5,7 @@ package networkinterface_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -220,3 +221,571 @@ resource "openwrt_network_interface" "testing" {
 		step,
 	)
 }
+
+func TestResourceStaticIPAddressWithoutNetmaskAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "testing" {
+	device = "br-testing"
+	id = "testing"
+	ipaddr = "192.168.3.1"
+	proto = "static"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceStaticIP6AddressAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "testing" {
+	device = "br-testing"
+	id = "testing"
+	ip6addr = "fd00:1::1/64"
+	ipaddr = "192.168.3.1"
+	netmask = "255.255.255.0"
+	proto = "static"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "ip6addr", "fd00:1::1/64"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "proto", "static"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+	)
+}
+
+func TestResource6in4Acceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "henet" {
+	id = "henet"
+	ip6addr = "2001:db8:1::2/64"
+	ip6prefix = "2001:db8:2::/48"
+	password = "update-key"
+	peeraddr = "192.0.2.1"
+	proto = "6in4"
+	tunnelid = "123456"
+	username = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "id", "henet"),
+			resource.TestCheckNoResourceAttr("openwrt_network_interface.henet", "device"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "ip6addr", "2001:db8:1::2/64"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "ip6prefix", "2001:db8:2::/48"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "password", "update-key"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "peeraddr", "192.0.2.1"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "proto", "6in4"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "tunnelid", "123456"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "username", "testing"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_interface.henet",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "henet" {
+	id = "henet"
+	ipaddr = "198.51.100.2"
+	mtu = 1480
+	peeraddr = "192.0.2.2"
+	proto = "6in4"
+	tos = "inherit"
+	ttl = 64
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "id", "henet"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "ipaddr", "198.51.100.2"),
+			resource.TestCheckNoResourceAttr("openwrt_network_interface.henet", "ip6prefix"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "mtu", "1480"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "peeraddr", "192.0.2.2"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "tos", "inherit"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.henet", "ttl", "64"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceGREAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "gre" {
+	id = "gre"
+	ikey = 42
+	ipaddr = "198.51.100.2"
+	okey = 43
+	peeraddr = "192.0.2.1"
+	proto = "gre"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.gre", "id", "gre"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.gre", "ikey", "42"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.gre", "ipaddr", "198.51.100.2"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.gre", "okey", "43"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.gre", "peeraddr", "192.0.2.1"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.gre", "proto", "gre"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourcePPPoEAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wan" {
+	device = "eth1"
+	id = "wan"
+	password = "secret"
+	proto = "pppoe"
+	username = "testing@isp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "id", "wan"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "device", "eth1"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "password", "secret"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "proto", "pppoe"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "username", "testing@isp"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_interface.wan",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wan" {
+	ac = "isp-bras"
+	device = "eth1"
+	id = "wan"
+	ipv6 = "1"
+	keepalive = "5 1"
+	password = "secret"
+	peerdns = false
+	proto = "pppoe"
+	service = "internet"
+	username = "testing@isp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "id", "wan"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "ac", "isp-bras"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "ipv6", "1"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "keepalive", "5 1"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "peerdns", "false"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wan", "service", "internet"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourcePPPoEOptionsWithoutPPPoEAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wan" {
+	device = "eth1"
+	id = "wan"
+	proto = "dhcp"
+	username = "testing@isp"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResource6rdWithoutPrefixAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wan6rd" {
+	id = "wan6rd"
+	peeraddr = "192.0.2.1"
+	proto = "6rd"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceStaticWithoutDeviceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "testing" {
+	id = "testing"
+	ipaddr = "192.168.3.1"
+	netmask = "255.255.255.0"
+	proto = "static"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceWireGuardAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wg0" {
+	addresses = [
+		"10.0.0.1/24",
+	]
+	id = "wg0"
+	private_key = "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="
+	proto = "wireguard"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "id", "wg0"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "addresses.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "addresses.0", "10.0.0.1/24"),
+			resource.TestCheckNoResourceAttr("openwrt_network_interface.wg0", "device"),
+			resource.TestCheckNoResourceAttr("openwrt_network_interface.wg0", "listen_port"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "private_key", "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "proto", "wireguard"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_interface.wg0",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wg0" {
+	addresses = [
+		"10.0.0.1/24",
+		"fd00::1/64",
+	]
+	id = "wg0"
+	listen_port = 51820
+	private_key = "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="
+	proto = "wireguard"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "id", "wg0"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "addresses.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "addresses.1", "fd00::1/64"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "listen_port", "51820"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.wg0", "proto", "wireguard"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceWireGuardWithDeviceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "wg0" {
+	device = "br-testing"
+	id = "wg0"
+	private_key = "yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="
+	proto = "wireguard"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceWireGuardOptionsWithoutWireGuardAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "testing" {
+	device = "br-testing"
+	id = "testing"
+	listen_port = 51820
+	proto = "dhcp"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceExtraOptionsAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "testing" {
+	device = "br-testing"
+	extra_options = {
+		ip6class = {
+			values = [
+				"local",
+				"wan6",
+			]
+		}
+		metric = {
+			value = "10"
+		}
+	}
+	id = "testing"
+	proto = "dhcp"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "extra_options.ip6class.values.0", "local"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "extra_options.ip6class.values.1", "wan6"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "extra_options.metric.value", "10"),
+			resource.TestCheckResourceAttr("openwrt_network_interface.testing", "proto", "dhcp"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceExtraOptionsClashAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_interface" "testing" {
+	device = "br-testing"
+	extra_options = {
+		proto = {
+			value = "static"
+		}
+	}
+	id = "testing"
+	proto = "dhcp"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
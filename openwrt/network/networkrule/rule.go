@@ -0,0 +1,44 @@
+package networkrule
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/internal/policyrule"
+)
+
+const (
+	destAttributeDescription = `Match traffic going to this IPv4 address or CIDR (e.g. "10.0.0.0/8").`
+
+	schemaDescription = "A policy routing rule for IPv4 traffic."
+
+	srcAttributeDescription = `Match traffic coming from this IPv4 address or CIDR (e.g. "192.168.1.0/24").`
+
+	uciType = "rule"
+)
+
+var (
+	addressFamily = policyrule.AddressFamily{
+		AddressValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}(/(3[0-2]|[12]?[[:digit:]]))?$`),
+				`must be a valid IPv4 address or CIDR (e.g. "192.168.1.1", "192.168.1.0/24")`,
+			),
+		},
+		DestAttributeDescription: destAttributeDescription,
+		SchemaDescription:        schemaDescription,
+		SrcAttributeDescription:  srcAttributeDescription,
+		UCIType:                  uciType,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return policyrule.NewDataSource(addressFamily)
+}
+
+func NewResource() resource.Resource {
+	return policyrule.NewResource(addressFamily)
+}
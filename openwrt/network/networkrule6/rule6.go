@@ -0,0 +1,44 @@
+package networkrule6
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/internal/policyrule"
+)
+
+const (
+	destAttributeDescription = `Match traffic going to this IPv6 address or CIDR (e.g. "fd00:1::/64").`
+
+	schemaDescription = "A policy routing rule for IPv6 traffic."
+
+	srcAttributeDescription = `Match traffic coming from this IPv6 address or CIDR (e.g. "fd00::/64").`
+
+	uciType = "rule6"
+)
+
+var (
+	addressFamily = policyrule.AddressFamily{
+		AddressValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:xdigit:]]{0,4}:){2,7}[[:xdigit:]]{0,4}(/(12[0-8]|1[01][[:digit:]]|[1-9]?[[:digit:]]))?$`),
+				`must be a valid IPv6 address or CIDR (e.g. "fd00::1", "fd00::/64")`,
+			),
+		},
+		DestAttributeDescription: destAttributeDescription,
+		SchemaDescription:        schemaDescription,
+		SrcAttributeDescription:  srcAttributeDescription,
+		UCIType:                  uciType,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return policyrule.NewDataSource(addressFamily)
+}
+
+func NewResource() resource.Resource {
+	return policyrule.NewResource(addressFamily)
+}
@@ -0,0 +1,197 @@
+//go:build acceptance.test
+
+package networkrule6_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"lookup":   lucirpc.String("100"),
+		"priority": lucirpc.Integer(1000),
+		"src":      lucirpc.String("fd00::/64"),
+	}
+	ok, err := client.CreateSection(ctx, "network", "rule6", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_network_rule6" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_network_rule6.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_network_rule6.testing", "lookup", "100"),
+			resource.TestCheckResourceAttr("data.openwrt_network_rule6.testing", "priority", "1000"),
+			resource.TestCheckResourceAttr("data.openwrt_network_rule6.testing", "src", "fd00::/64"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_rule6" "testing" {
+	id = "testing"
+	in = "lan"
+	lookup = "100"
+	priority = 1000
+	src = "fd00::/64"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "in", "lan"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "invert", "false"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "lookup", "100"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "priority", "1000"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "src", "fd00::/64"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_rule6.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_rule6" "testing" {
+	action = "prohibit"
+	dest = "fd00:1::/64"
+	id = "testing"
+	invert = true
+	mark = "0x1/0xff"
+	out = "wan"
+	priority = 2000
+	tos = 16
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "action", "prohibit"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "dest", "fd00:1::/64"),
+			resource.TestCheckNoResourceAttr("openwrt_network_rule6.testing", "in"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "invert", "true"),
+			resource.TestCheckNoResourceAttr("openwrt_network_rule6.testing", "lookup"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "mark", "0x1/0xff"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "out", "wan"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "priority", "2000"),
+			resource.TestCheckNoResourceAttr("openwrt_network_rule6.testing", "src"),
+			resource.TestCheckResourceAttr("openwrt_network_rule6.testing", "tos", "16"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceLookupAndActionAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_rule6" "testing" {
+	action = "blackhole"
+	id = "testing"
+	lookup = "100"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceWrongFamilyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_rule6" "testing" {
+	id = "testing"
+	lookup = "100"
+	src = "192.168.1.0/24"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
@@ -13,15 +14,18 @@ import (
 
 const (
 	enableMirrorReceivedAttribute            = "enable_mirror_rx"
-	enableMirrorReceivedAttributeDescription = "Mirror received packets from the `mirror_source_port` to the `mirror_monitor_port`."
+	enableMirrorReceivedAttributeDescription = "Mirror received packets from the `mirror_source_port` to the `mirror_monitor_port`. Defaults to `false`."
+	enableMirrorReceivedDefaultValue         = false
 	enableMirrorReceivedUCIOption            = "enable_mirror_rx"
 
 	enableMirrorTransmittedAttribute            = "enable_mirror_tx"
-	enableMirrorTransmittedAttributeDescription = "Mirror transmitted packets from the `mirror_source_port` to the `mirror_monitor_port`."
+	enableMirrorTransmittedAttributeDescription = "Mirror transmitted packets from the `mirror_source_port` to the `mirror_monitor_port`. Defaults to `false`."
+	enableMirrorTransmittedDefaultValue         = false
 	enableMirrorTransmittedUCIOption            = "enable_mirror_tx"
 
 	enableVLANAttribute            = "enable_vlan"
-	enableVLANAttributeDescription = "Enables VLAN functionality."
+	enableVLANAttributeDescription = "Enables VLAN functionality. Defaults to `true`."
+	enableVLANDefaultValue         = true
 	enableVLANUCIOption            = "enable_vlan"
 
 	mirrorMonitorPortAttribute            = "mirror_monitor_port"
@@ -37,10 +41,12 @@ const (
 	nameUCIOption            = "name"
 
 	resetAttribute            = "reset"
-	resetAttributeDescription = "Reset the switch."
+	resetAttributeDescription = "Reset the switch. Defaults to `true`."
+	resetDefaultValue         = true
 	resetUCIOption            = "reset"
 
 	schemaDescription = "Legacy `swconfig` configuration"
+	schemaVersion     = 0
 
 	uciConfig = "network"
 	uciType   = "switch"
@@ -48,6 +54,7 @@ const (
 
 var (
 	enableMirrorReceivedSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enableMirrorReceivedDefaultValue),
 		Description:       enableMirrorReceivedAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnableMirrorReceived, enableMirrorReceivedAttribute, enableMirrorReceivedUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -55,6 +62,7 @@ var (
 	}
 
 	enableMirrorTransmittedSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enableMirrorTransmittedDefaultValue),
 		Description:       enableMirrorTransmittedAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnableMirrorTransmitted, enableMirrorTransmittedAttribute, enableMirrorTransmittedUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -62,6 +70,7 @@ var (
 	}
 
 	enableVLANSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(enableVLANDefaultValue),
 		Description:       enableVLANAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetEnableVLAN, enableVLANAttribute, enableVLANUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -114,6 +123,7 @@ var (
 	}
 
 	resetSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(resetDefaultValue),
 		Description:       resetAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetReset, resetAttribute, resetUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -121,14 +131,15 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		enableMirrorReceivedAttribute:    enableMirrorReceivedSchemaAttribute,
-		enableMirrorTransmittedAttribute: enableMirrorTransmittedSchemaAttribute,
-		enableVLANAttribute:              enableVLANSchemaAttribute,
-		lucirpcglue.IdAttribute:          lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		mirrorMonitorPortAttribute:       mirrorMonitorPortSchemaAttribute,
-		mirrorSourcePortAttribute:        mirrorSourcePortSchemaAttribute,
-		nameAttribute:                    nameSchemaAttribute,
-		resetAttribute:                   resetSchemaAttribute,
+		enableMirrorReceivedAttribute:     enableMirrorReceivedSchemaAttribute,
+		enableMirrorTransmittedAttribute:  enableMirrorTransmittedSchemaAttribute,
+		enableVLANAttribute:               enableVLANSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		mirrorMonitorPortAttribute:        mirrorMonitorPortSchemaAttribute,
+		mirrorSourcePortAttribute:         mirrorSourcePortSchemaAttribute,
+		nameAttribute:                     nameSchemaAttribute,
+		resetAttribute:                    resetSchemaAttribute,
 	}
 )
 
@@ -144,9 +155,12 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
@@ -156,6 +170,7 @@ type model struct {
 	EnableMirrorReceived    types.Bool   `tfsdk:"enable_mirror_rx"`
 	EnableMirrorTransmitted types.Bool   `tfsdk:"enable_mirror_tx"`
 	EnableVLAN              types.Bool   `tfsdk:"enable_vlan"`
+	ExtraOptions            types.Map    `tfsdk:"extra_options"`
 	Id                      types.String `tfsdk:"id"`
 	MirrorMonitorPort       types.Int64  `tfsdk:"mirror_monitor_port"`
 	MirrorSourcePort        types.Int64  `tfsdk:"mirror_source_port"`
@@ -166,6 +181,7 @@ type model struct {
 func modelGetEnableMirrorReceived(m model) types.Bool    { return m.EnableMirrorReceived }
 func modelGetEnableMirrorTransmitted(m model) types.Bool { return m.EnableMirrorTransmitted }
 func modelGetEnableVLAN(m model) types.Bool              { return m.EnableVLAN }
+func modelGetExtraOptions(m model) types.Map             { return m.ExtraOptions }
 func modelGetId(m model) types.String                    { return m.Id }
 func modelGetMirrorMonitorPort(m model) types.Int64      { return m.MirrorMonitorPort }
 func modelGetMirrorSourcePort(m model) types.Int64       { return m.MirrorSourcePort }
@@ -175,6 +191,7 @@ func modelGetReset(m model) types.Bool                   { return m.Reset }
 func modelSetEnableMirrorReceived(m *model, value types.Bool)    { m.EnableMirrorReceived = value }
 func modelSetEnableMirrorTransmitted(m *model, value types.Bool) { m.EnableMirrorTransmitted = value }
 func modelSetEnableVLAN(m *model, value types.Bool)              { m.EnableVLAN = value }
+func modelSetExtraOptions(m *model, value types.Map)             { m.ExtraOptions = value }
 func modelSetId(m *model, value types.String)                    { m.Id = value }
 func modelSetMirrorMonitorPort(m *model, value types.Int64)      { m.MirrorMonitorPort = value }
 func modelSetMirrorSourcePort(m *model, value types.Int64)       { m.MirrorSourcePort = value }
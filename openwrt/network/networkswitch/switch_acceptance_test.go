@@ -79,7 +79,7 @@ resource "openwrt_network_switch" "testing" {
 		Check: resource.ComposeAggregateTestCheckFunc(
 			resource.TestCheckResourceAttr("openwrt_network_switch.testing", "id", "testing"),
 			resource.TestCheckResourceAttr("openwrt_network_switch.testing", "name", "switch0"),
-			resource.TestCheckNoResourceAttr("openwrt_network_switch.testing", "enable_vlan"),
+			resource.TestCheckResourceAttr("openwrt_network_switch.testing", "enable_vlan", "true"),
 		),
 	}
 	importValidation := resource.TestStep{
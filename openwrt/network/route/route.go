@@ -0,0 +1,66 @@
+package route
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/internal/staticroute"
+)
+
+const (
+	gatewayAttributeDescription = `IPv4 address of the next hop (e.g. "192.168.1.1"). If unset, the route is on-link through the interface.`
+
+	mtuAttributeDescription = "MTU to use for traffic along this route. Must be in the range: `[68, 9200]`."
+
+	schemaDescription = "A static IPv4 route."
+
+	sourceAttributeDescription = `Preferred source IPv4 address or CIDR for traffic along this route (e.g. "192.168.1.1").`
+
+	targetAttributeDescription = `Destination IPv4 network in CIDR notation (e.g. "10.0.0.0/8"). Use "0.0.0.0/0" for a default route.`
+
+	uciType = "route"
+)
+
+var (
+	addressFamily = staticroute.AddressFamily{
+		GatewayAttributeDescription: gatewayAttributeDescription,
+		GatewayValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}$`),
+				`must be a valid IPv4 address (e.g. "192.168.1.1")`,
+			),
+		},
+		MTUAttributeDescription: mtuAttributeDescription,
+		MTUValidators: []validator.Int64{
+			int64validator.Between(68, 9200),
+		},
+		SchemaDescription:          schemaDescription,
+		SourceAttributeDescription: sourceAttributeDescription,
+		SourceValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}(/(3[0-2]|[12]?[[:digit:]]))?$`),
+				`must be a valid IPv4 address or CIDR (e.g. "192.168.1.1", "192.168.1.0/24")`,
+			),
+		},
+		TargetAttributeDescription: targetAttributeDescription,
+		TargetValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:digit:]]{1,3}\.){3}[[:digit:]]{1,3}/(3[0-2]|[12]?[[:digit:]])$`),
+				`must be a valid IPv4 CIDR (e.g. "10.0.0.0/8")`,
+			),
+		},
+		UCIType: uciType,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return staticroute.NewDataSource(addressFamily)
+}
+
+func NewResource() resource.Resource {
+	return staticroute.NewResource(addressFamily)
+}
@@ -0,0 +1,167 @@
+//go:build acceptance.test
+
+package route_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"gateway":   lucirpc.String("192.168.1.2"),
+		"interface": lucirpc.String("lan"),
+		"metric":    lucirpc.Integer(10),
+		"target":    lucirpc.String("10.0.0.0/8"),
+	}
+	ok, err := client.CreateSection(ctx, "network", "route", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_network_route" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_network_route.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_network_route.testing", "gateway", "192.168.1.2"),
+			resource.TestCheckResourceAttr("data.openwrt_network_route.testing", "interface", "lan"),
+			resource.TestCheckResourceAttr("data.openwrt_network_route.testing", "metric", "10"),
+			resource.TestCheckResourceAttr("data.openwrt_network_route.testing", "target", "10.0.0.0/8"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_route" "testing" {
+	gateway = "192.168.1.2"
+	id = "testing"
+	interface = "lan"
+	target = "10.0.0.0/8"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "gateway", "192.168.1.2"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "interface", "lan"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "onlink", "false"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "target", "10.0.0.0/8"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "type", "unicast"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_route.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_route" "testing" {
+	gateway = "192.168.1.254"
+	id = "testing"
+	interface = "wan"
+	metric = 100
+	mtu = 1400
+	onlink = true
+	source = "192.168.1.1"
+	table = "100"
+	target = "0.0.0.0/0"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "gateway", "192.168.1.254"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "interface", "wan"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "metric", "100"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "mtu", "1400"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "onlink", "true"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "source", "192.168.1.1"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "table", "100"),
+			resource.TestCheckResourceAttr("openwrt_network_route.testing", "target", "0.0.0.0/0"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceWrongFamilyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_route" "testing" {
+	id = "testing"
+	interface = "lan"
+	target = "fd00:1::/64"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,66 @@
+package route6
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/internal/staticroute"
+)
+
+const (
+	gatewayAttributeDescription = `IPv6 address of the next hop (e.g. "fe80::1"). If unset, the route is on-link through the interface.`
+
+	mtuAttributeDescription = "MTU to use for traffic along this route. Must be in the range: `[1280, 9200]`."
+
+	schemaDescription = "A static IPv6 route."
+
+	sourceAttributeDescription = `Preferred source IPv6 address or CIDR for traffic along this route (e.g. "fd00::1").`
+
+	targetAttributeDescription = `Destination IPv6 network in CIDR notation (e.g. "fd00:1::/64"). Use "::/0" for a default route.`
+
+	uciType = "route6"
+)
+
+var (
+	addressFamily = staticroute.AddressFamily{
+		GatewayAttributeDescription: gatewayAttributeDescription,
+		GatewayValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:xdigit:]]{0,4}:){2,7}[[:xdigit:]]{0,4}$`),
+				`must be a valid IPv6 address (e.g. "fe80::1")`,
+			),
+		},
+		MTUAttributeDescription: mtuAttributeDescription,
+		MTUValidators: []validator.Int64{
+			int64validator.Between(1280, 9200),
+		},
+		SchemaDescription:          schemaDescription,
+		SourceAttributeDescription: sourceAttributeDescription,
+		SourceValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:xdigit:]]{0,4}:){2,7}[[:xdigit:]]{0,4}(/(12[0-8]|1[01][[:digit:]]|[1-9]?[[:digit:]]))?$`),
+				`must be a valid IPv6 address or CIDR (e.g. "fd00::1", "fd00::/64")`,
+			),
+		},
+		TargetAttributeDescription: targetAttributeDescription,
+		TargetValidators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^([[:xdigit:]]{0,4}:){2,7}[[:xdigit:]]{0,4}/(12[0-8]|1[01][[:digit:]]|[1-9]?[[:digit:]])$`),
+				`must be a valid IPv6 CIDR (e.g. "fd00:1::/64")`,
+			),
+		},
+		UCIType: uciType,
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return staticroute.NewDataSource(addressFamily)
+}
+
+func NewResource() resource.Resource {
+	return staticroute.NewResource(addressFamily)
+}
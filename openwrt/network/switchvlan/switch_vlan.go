@@ -24,6 +24,7 @@ const (
 	portsUCIOption            = "ports"
 
 	schemaDescription = "Legacy VLAN configuration"
+	schemaVersion     = 0
 
 	uciConfig = "network"
 	uciType   = "switch_vlan"
@@ -60,12 +61,13 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		descriptionAttribute:    descriptionSchemaAttribute,
-		deviceAttribute:         deviceSchemaAttribute,
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		portsAttribute:          portsSchemaAttribute,
-		vIdAttribute:            vIdSchemaAttribute,
-		vLanAttribute:           vLanSchemaAttribute,
+		descriptionAttribute:              descriptionSchemaAttribute,
+		deviceAttribute:                   deviceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.RenamableIdSchemaAttribute(modelGetId, modelSetId),
+		portsAttribute:                    portsSchemaAttribute,
+		vIdAttribute:                      vIdSchemaAttribute,
+		vLanAttribute:                     vLanSchemaAttribute,
 	}
 
 	vIdSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
@@ -101,25 +103,30 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
-	Description types.String `tfsdk:"description"`
-	Device      types.String `tfsdk:"device"`
-	Id          types.String `tfsdk:"id"`
-	Ports       types.String `tfsdk:"ports"`
-	VId         types.Int64  `tfsdk:"vid"`
-	VLan        types.Int64  `tfsdk:"vlan"`
+	Description  types.String `tfsdk:"description"`
+	Device       types.String `tfsdk:"device"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Ports        types.String `tfsdk:"ports"`
+	VId          types.Int64  `tfsdk:"vid"`
+	VLan         types.Int64  `tfsdk:"vlan"`
 }
 
 func modelGetDescription(m model) types.String { return m.Description }
 func modelGetDevice(m model) types.String      { return m.Device }
+func modelGetExtraOptions(m model) types.Map   { return m.ExtraOptions }
 func modelGetId(m model) types.String          { return m.Id }
 func modelGetPorts(m model) types.String       { return m.Ports }
 func modelGetVId(m model) types.Int64          { return m.VId }
@@ -127,6 +134,7 @@ func modelGetVLan(m model) types.Int64         { return m.VLan }
 
 func modelSetDescription(m *model, value types.String) { m.Description = value }
 func modelSetDevice(m *model, value types.String)      { m.Device = value }
+func modelSetExtraOptions(m *model, value types.Map)   { m.ExtraOptions = value }
 func modelSetId(m *model, value types.String)          { m.Id = value }
 func modelSetPorts(m *model, value types.String)       { m.Ports = value }
 func modelSetVId(m *model, value types.Int64)          { m.VId = value }
@@ -0,0 +1,291 @@
+package wireguardpeer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/logger"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	allowedIPsAttribute            = "allowed_ips"
+	allowedIPsAttributeDescription = `Addresses in CIDR notation this peer may send traffic from, and which traffic is sent to this peer for (e.g. "10.0.0.2/32").`
+	allowedIPsUCIOption            = "allowed_ips"
+
+	descriptionAttribute            = "description"
+	descriptionAttributeDescription = "Human-readable name of the peer."
+	descriptionUCIOption            = "description"
+
+	endpointHostAttribute            = "endpoint_host"
+	endpointHostAttributeDescription = "Host name or IP address of the peer. If unset, the peer must initiate the connection."
+	endpointHostUCIOption            = "endpoint_host"
+
+	endpointPortAttribute            = "endpoint_port"
+	endpointPortAttributeDescription = "Port of the peer. Must be in the range: `[1, 65535]`. If unset, `51820` is used."
+	endpointPortUCIOption            = "endpoint_port"
+
+	interfaceAttribute            = "interface"
+	interfaceAttributeDescription = "Name of the WireGuard interface the peer belongs to. This is the `id` of an `openwrt_network_interface`. UCI stores this as the type of the section (i.e. `wireguard_<interface>`), so changing it replaces the peer."
+	interfaceUCITypePrefix        = "wireguard_"
+
+	persistentKeepaliveAttribute            = "persistent_keepalive"
+	persistentKeepaliveAttributeDescription = "Seconds between keepalive packets sent to the peer. Must be in the range: `[0, 65535]`. If unset or `0`, no keepalive packets are sent."
+	persistentKeepaliveUCIOption            = "persistent_keepalive"
+
+	presharedKeyAttribute            = "preshared_key"
+	presharedKeyAttributeDescription = "Base64-encoded pre-shared key for an additional layer of symmetric encryption (e.g. the output of `wg genpsk`)."
+	presharedKeyUCIOption            = "preshared_key"
+
+	publicKeyAttribute            = "public_key"
+	publicKeyAttributeDescription = "Base64-encoded public key of the peer."
+	publicKeyUCIOption            = "public_key"
+
+	routeAllowedIPsAttribute            = "route_allowed_ips"
+	routeAllowedIPsAttributeDescription = "Create routes through the interface for `allowed_ips`. Defaults to `false`."
+	routeAllowedIPsDefaultValue         = false
+	routeAllowedIPsUCIOption            = "route_allowed_ips"
+
+	schemaDescription = "A peer of a WireGuard interface."
+	schemaVersion     = 0
+
+	typeUCISection = ".type"
+
+	uciConfig = "network"
+	uciType   = "wireguard_peer"
+)
+
+var (
+	allowedIPsSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       allowedIPsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetAllowedIPs, allowedIPsAttribute, allowedIPsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetAllowedIPs, allowedIPsAttribute, allowedIPsUCIOption),
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[[:xdigit:].:]+(/[[:digit:]]{1,3})?$"),
+					`must be a valid address in CIDR notation (e.g. "10.0.0.2/32")`,
+				),
+			),
+		},
+	}
+
+	descriptionSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       descriptionAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDescription, descriptionAttribute, descriptionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDescription, descriptionAttribute, descriptionUCIOption),
+	}
+
+	endpointHostSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       endpointHostAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetEndpointHost, endpointHostAttribute, endpointHostUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetEndpointHost, endpointHostAttribute, endpointHostUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	endpointPortSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       endpointPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetEndpointPort, endpointPortAttribute, endpointPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetEndpointPort, endpointPortAttribute, endpointPortUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+		},
+	}
+
+	interfaceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description: interfaceAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		ReadResponse:      readResponseInterface,
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     upsertRequestInterface,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:alnum:]_]+$"),
+				"must be a valid interface name",
+			),
+		},
+	}
+
+	keyValidators = []validator.String{
+		stringvalidator.RegexMatches(
+			regexp.MustCompile("^[[:alnum:]+/]{43}=$"),
+			"must be a base64-encoded WireGuard key",
+		),
+	}
+
+	persistentKeepaliveSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       persistentKeepaliveAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetPersistentKeepalive, persistentKeepaliveAttribute, persistentKeepaliveUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetPersistentKeepalive, persistentKeepaliveAttribute, persistentKeepaliveUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+		},
+	}
+
+	presharedKeySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       presharedKeyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPresharedKey, presharedKeyAttribute, presharedKeyUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		Sensitive:         true,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPresharedKey, presharedKeyAttribute, presharedKeyUCIOption),
+		Validators:        keyValidators,
+	}
+
+	publicKeySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       publicKeyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPublicKey, publicKeyAttribute, publicKeyUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetPublicKey, publicKeyAttribute, publicKeyUCIOption),
+		Validators:        keyValidators,
+	}
+
+	routeAllowedIPsSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(routeAllowedIPsDefaultValue),
+		Description:       routeAllowedIPsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetRouteAllowedIPs, routeAllowedIPsAttribute, routeAllowedIPsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetRouteAllowedIPs, routeAllowedIPsAttribute, routeAllowedIPsUCIOption),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		allowedIPsAttribute:               allowedIPsSchemaAttribute,
+		descriptionAttribute:              descriptionSchemaAttribute,
+		endpointHostAttribute:             endpointHostSchemaAttribute,
+		endpointPortAttribute:             endpointPortSchemaAttribute,
+		interfaceAttribute:                interfaceSchemaAttribute,
+		persistentKeepaliveAttribute:      persistentKeepaliveSchemaAttribute,
+		presharedKeyAttribute:             presharedKeySchemaAttribute,
+		publicKeyAttribute:                publicKeySchemaAttribute,
+		routeAllowedIPsAttribute:          routeAllowedIPsSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResourceWithDynamicType(
+		nil,
+		modelGetId,
+		modelGetUCIType,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	AllowedIPs          types.List   `tfsdk:"allowed_ips"`
+	Description         types.String `tfsdk:"description"`
+	EndpointHost        types.String `tfsdk:"endpoint_host"`
+	EndpointPort        types.Int64  `tfsdk:"endpoint_port"`
+	ExtraOptions        types.Map    `tfsdk:"extra_options"`
+	Id                  types.String `tfsdk:"id"`
+	Interface           types.String `tfsdk:"interface"`
+	PersistentKeepalive types.Int64  `tfsdk:"persistent_keepalive"`
+	PresharedKey        types.String `tfsdk:"preshared_key"`
+	PublicKey           types.String `tfsdk:"public_key"`
+	RouteAllowedIPs     types.Bool   `tfsdk:"route_allowed_ips"`
+}
+
+func modelGetAllowedIPs(m model) types.List           { return m.AllowedIPs }
+func modelGetDescription(m model) types.String        { return m.Description }
+func modelGetEndpointHost(m model) types.String       { return m.EndpointHost }
+func modelGetEndpointPort(m model) types.Int64        { return m.EndpointPort }
+func modelGetExtraOptions(m model) types.Map          { return m.ExtraOptions }
+func modelGetId(m model) types.String                 { return m.Id }
+func modelGetInterface(m model) types.String          { return m.Interface }
+func modelGetPersistentKeepalive(m model) types.Int64 { return m.PersistentKeepalive }
+func modelGetPresharedKey(m model) types.String       { return m.PresharedKey }
+func modelGetPublicKey(m model) types.String          { return m.PublicKey }
+func modelGetRouteAllowedIPs(m model) types.Bool      { return m.RouteAllowedIPs }
+func modelGetUCIType(m model) string                  { return interfaceUCITypePrefix + m.Interface.ValueString() }
+
+func modelSetAllowedIPs(m *model, value types.List)           { m.AllowedIPs = value }
+func modelSetDescription(m *model, value types.String)        { m.Description = value }
+func modelSetEndpointHost(m *model, value types.String)       { m.EndpointHost = value }
+func modelSetEndpointPort(m *model, value types.Int64)        { m.EndpointPort = value }
+func modelSetExtraOptions(m *model, value types.Map)          { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)                 { m.Id = value }
+func modelSetInterface(m *model, value types.String)          { m.Interface = value }
+func modelSetPersistentKeepalive(m *model, value types.Int64) { m.PersistentKeepalive = value }
+func modelSetPresharedKey(m *model, value types.String)       { m.PresharedKey = value }
+func modelSetPublicKey(m *model, value types.String)          { m.PublicKey = value }
+func modelSetRouteAllowedIPs(m *model, value types.Bool)      { m.RouteAllowedIPs = value }
+
+// readResponseInterface reads the interface from the type of the section,
+// since WireGuard peers don't have an option for it.
+func readResponseInterface(
+	ctx context.Context,
+	fullTypeName string,
+	terraformType string,
+	section lucirpc.Options,
+	m model,
+) (context.Context, model, diag.Diagnostics) {
+	ctx, sectionType, diagnostics := lucirpcglue.GetMetadataString(ctx, fullTypeName, terraformType, section, typeUCISection)
+	if diagnostics.HasError() {
+		return ctx, m, diagnostics
+	}
+
+	iface, ok := strings.CutPrefix(sectionType.ValueString(), interfaceUCITypePrefix)
+	if !ok {
+		diagnostics.AddError(
+			fmt.Sprintf("unable to parse metadata: %q", typeUCISection),
+			fmt.Sprintf("expected a section type starting with %q, got: %q", interfaceUCITypePrefix, sectionType.ValueString()),
+		)
+		return ctx, m, diagnostics
+	}
+
+	value := types.StringValue(iface)
+	ctx = logger.SetFieldString(ctx, fullTypeName, terraformType, interfaceAttribute, value)
+	modelSetInterface(&m, value)
+	return ctx, m, diagnostics
+}
+
+// upsertRequestInterface doesn't add anything to the request,
+// since the interface is part of the type of the section.
+func upsertRequestInterface(
+	ctx context.Context,
+	fullTypeName string,
+	options lucirpc.Options,
+	m model,
+) (context.Context, lucirpc.Options, diag.Diagnostics) {
+	ctx = logger.SetFieldString(ctx, fullTypeName, lucirpcglue.ResourceTerraformType, interfaceAttribute, modelGetInterface(m))
+	return ctx, options, diag.Diagnostics{}
+}
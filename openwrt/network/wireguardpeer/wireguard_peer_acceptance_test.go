@@ -0,0 +1,229 @@
+//go:build acceptance.test
+
+package wireguardpeer_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	client := openWrtServer.LuCIRPCClient(
+		ctx,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+	options := lucirpc.Options{
+		"allowed_ips":   lucirpc.ListString([]string{"10.0.0.2/32"}),
+		"description":   lucirpc.String("laptop"),
+		"endpoint_host": lucirpc.String("vpn.example.com"),
+		"endpoint_port": lucirpc.Integer(51820),
+		"public_key":    lucirpc.String("HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="),
+	}
+	ok, err := client.CreateSection(ctx, "network", "wireguard_wg0", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_network_wireguard_peer" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "allowed_ips.#", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "allowed_ips.0", "10.0.0.2/32"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "description", "laptop"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "endpoint_host", "vpn.example.com"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "endpoint_port", "51820"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "interface", "wg0"),
+			resource.TestCheckResourceAttr("data.openwrt_network_wireguard_peer.testing", "public_key", "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_wireguard_peer" "testing" {
+	allowed_ips = [
+		"10.0.0.2/32",
+	]
+	id = "testing"
+	interface = "wg0"
+	public_key = "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "allowed_ips.#", "1"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "allowed_ips.0", "10.0.0.2/32"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "interface", "wg0"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "public_key", "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "route_allowed_ips", "false"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_network_wireguard_peer.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_wireguard_peer" "testing" {
+	allowed_ips = [
+		"10.0.0.2/32",
+		"192.168.2.0/24",
+	]
+	description = "branch office"
+	endpoint_host = "vpn.example.com"
+	endpoint_port = 51821
+	id = "testing"
+	interface = "wg0"
+	persistent_keepalive = 25
+	preshared_key = "FpCyhws9cxwWoV4xELtfJvjJN+zQVRPISllRWgeopVE="
+	public_key = "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="
+	route_allowed_ips = true
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "allowed_ips.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "allowed_ips.1", "192.168.2.0/24"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "description", "branch office"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "endpoint_host", "vpn.example.com"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "endpoint_port", "51821"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "interface", "wg0"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "persistent_keepalive", "25"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "preshared_key", "FpCyhws9cxwWoV4xELtfJvjJN+zQVRPISllRWgeopVE="),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "route_allowed_ips", "true"),
+		),
+	}
+	changeInterface := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_wireguard_peer" "testing" {
+	id = "testing"
+	interface = "wg1"
+	public_key = "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "interface", "wg1"),
+			resource.TestCheckNoResourceAttr("openwrt_network_wireguard_peer.testing", "allowed_ips"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+		changeInterface,
+	)
+}
+
+func TestResourceAnonymousAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_wireguard_peer" "testing" {
+	interface = "wg0"
+	public_key = "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_network_wireguard_peer.testing", "id", regexp.MustCompile("^wireguard_wg0_[[:xdigit:]]{8}$")),
+			resource.TestCheckResourceAttr("openwrt_network_wireguard_peer.testing", "interface", "wg0"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+	)
+}
+
+func TestResourceInvalidPublicKeyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_network_wireguard_peer" "testing" {
+	id = "testing"
+	interface = "wg0"
+	public_key = "not-a-key"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
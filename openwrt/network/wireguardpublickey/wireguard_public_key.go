@@ -0,0 +1,140 @@
+package wireguardpublickey
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	idAttribute            = "id"
+	idAttributeDescription = "Same as `public_key`."
+
+	privateKeyAttribute            = "private_key"
+	privateKeyAttributeDescription = "Base64-encoded private key of a WireGuard interface (e.g. the `private_key` of an `openwrt_network_interface`)."
+
+	publicKeyAttribute            = "public_key"
+	publicKeyAttributeDescription = "Base64-encoded public key derived from `private_key`. This is what peers use as their `public_key`."
+
+	schemaDescription = "Derives the public key of a WireGuard private key, like `wg pubkey` does. The private key never leaves Terraform."
+
+	typeName = "network_wireguard_public_key"
+)
+
+var (
+	_ datasource.DataSource = &dataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &dataSource{}
+}
+
+type dataSource struct {
+	fullTypeName string
+}
+
+type model struct {
+	Id         types.String `tfsdk:"id"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	PublicKey  types.String `tfsdk:"public_key"`
+}
+
+// Metadata sets the data source name.
+func (d *dataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	res *datasource.MetadataResponse,
+) {
+	d.fullTypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, typeName)
+	res.TypeName = d.fullTypeName
+}
+
+// Read derives the public key from the configured private key.
+// It does not talk to the device.
+func (d *dataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	res *datasource.ReadResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s data source", d.fullTypeName))
+
+	tflog.Debug(ctx, "Retrieving values from config")
+	var config model
+	diagnostics := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, err := base64.StdEncoding.DecodeString(config.PrivateKey.ValueString())
+	if err != nil {
+		res.Diagnostics.AddAttributeError(
+			path.Root(privateKeyAttribute),
+			"unable to decode private key",
+			err.Error(),
+		)
+		return
+	}
+
+	publicKey, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		res.Diagnostics.AddAttributeError(
+			path.Root(privateKeyAttribute),
+			"unable to derive public key",
+			err.Error(),
+		)
+		return
+	}
+
+	config.PublicKey = types.StringValue(base64.StdEncoding.EncodeToString(publicKey))
+	config.Id = config.PublicKey
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting the %s data source state", d.fullTypeName))
+	diagnostics = res.State.Set(ctx, config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	res *datasource.SchemaResponse,
+) {
+	res.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			idAttribute: schema.StringAttribute{
+				Computed:    true,
+				Description: idAttributeDescription,
+			},
+			privateKeyAttribute: schema.StringAttribute{
+				Description: privateKeyAttributeDescription,
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^[[:alnum:]+/]{43}=$"),
+						"must be a base64-encoded WireGuard key",
+					),
+				},
+			},
+			publicKeyAttribute: schema.StringAttribute{
+				Computed:    true,
+				Description: publicKeyAttributeDescription,
+			},
+		},
+		Description: schemaDescription,
+	}
+}
@@ -16,20 +16,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/boot"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/cname"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/dhcp"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/dnsmasq"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/domain"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/host"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/match"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/mxhost"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/odhcpd"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/relay"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/srvhost"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/dhcp/tag"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/defaults"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/forwarding"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/include"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/ipset"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/redirect"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/rule"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/firewall/zone"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/bridgevlan"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/device"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/globals"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/networkinterface"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/networkrule"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/networkrule6"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/networkswitch"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/route"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/route6"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/switchvlan"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/wireguardpeer"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/network/wireguardpublickey"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/system/system"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/assoclist"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/fasttransitionkeyholders"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/scan"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/status"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/wifidevice"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/wifiiface"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/wifistation"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/wireless/wifivlan"
 )
 
 const (
@@ -162,19 +189,46 @@ func (p *openWrtProvider) DataSources(
 	ctx context.Context,
 ) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		assoclist.NewDataSource,
+		boot.NewDataSource,
+		bridgevlan.NewDataSource,
+		cname.NewDataSource,
+		defaults.NewDataSource,
 		device.NewDataSource,
 		dhcp.NewDataSource,
 		dnsmasq.NewDataSource,
 		domain.NewDataSource,
+		fasttransitionkeyholders.NewDataSource,
+		forwarding.NewDataSource,
 		globals.NewDataSource,
 		host.NewDataSource,
+		include.NewDataSource,
+		ipset.NewDataSource,
+		match.NewDataSource,
+		mxhost.NewDataSource,
 		networkinterface.NewDataSource,
+		networkrule.NewDataSource,
+		networkrule6.NewDataSource,
 		networkswitch.NewDataSource,
 		odhcpd.NewDataSource,
+		redirect.NewDataSource,
+		relay.NewDataSource,
+		route.NewDataSource,
+		route6.NewDataSource,
+		rule.NewDataSource,
+		scan.NewDataSource,
+		srvhost.NewDataSource,
+		status.NewDataSource,
 		switchvlan.NewDataSource,
 		system.NewDataSource,
+		tag.NewDataSource,
 		wifidevice.NewDataSource,
 		wifiiface.NewDataSource,
+		wifistation.NewDataSource,
+		wifivlan.NewDataSource,
+		wireguardpeer.NewDataSource,
+		wireguardpublickey.NewDataSource,
+		zone.NewDataSource,
 	}
 }
 
@@ -193,19 +247,41 @@ func (p *openWrtProvider) Resources(
 	ctx context.Context,
 ) []func() resource.Resource {
 	return []func() resource.Resource{
+		boot.NewResource,
+		bridgevlan.NewResource,
+		cname.NewResource,
+		defaults.NewResource,
 		device.NewResource,
 		dhcp.NewResource,
 		dnsmasq.NewResource,
 		domain.NewResource,
+		forwarding.NewResource,
 		globals.NewResource,
 		host.NewResource,
+		include.NewResource,
+		ipset.NewResource,
+		match.NewResource,
+		mxhost.NewResource,
 		networkinterface.NewResource,
+		networkrule.NewResource,
+		networkrule6.NewResource,
 		networkswitch.NewResource,
 		odhcpd.NewResource,
+		redirect.NewResource,
+		relay.NewResource,
+		route.NewResource,
+		route6.NewResource,
+		rule.NewResource,
+		srvhost.NewResource,
 		switchvlan.NewResource,
 		system.NewResource,
+		tag.NewResource,
 		wifidevice.NewResource,
 		wifiiface.NewResource,
+		wifistation.NewResource,
+		wifivlan.NewResource,
+		wireguardpeer.NewResource,
+		zone.NewResource,
 	}
 }
 
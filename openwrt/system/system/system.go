@@ -3,17 +3,21 @@ package system
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
 )
 
 const (
-	conLogLevelAttribute = "conloglevel"
-	conLogLevelUCIOption = "conloglevel"
+	conLogLevelAttribute    = "conloglevel"
+	conLogLevelDefaultValue = 7
+	conLogLevelUCIOption    = "conloglevel"
 
-	cronLogLevelAttribute = "cronloglevel"
-	cronLogLevelUCIOption = "cronloglevel"
+	cronLogLevelAttribute    = "cronloglevel"
+	cronLogLevelDefaultValue = 5
+	cronLogLevelUCIOption    = "cronloglevel"
 
 	descriptionAttribute = "description"
 	descriptionUCIOption = "description"
@@ -21,19 +25,22 @@ const (
 	hostnameAttribute = "hostname"
 	hostnameUCIOption = "hostname"
 
-	logSizeAttribute = "log_size"
-	logSizeUCIOption = "log_size"
+	logSizeAttribute    = "log_size"
+	logSizeDefaultValue = 64
+	logSizeUCIOption    = "log_size"
 
 	notesAttribute = "notes"
 	notesUCIOption = "notes"
 
 	schemaDescription = "Provides system data about an OpenWrt device"
+	schemaVersion     = 0
 
 	timezoneAttribute = "timezone"
 	timezoneUCIOption = "timezone"
 
-	ttyLoginAttribute = "ttylogin"
-	ttyLoginUCIOption = "ttylogin"
+	ttyLoginAttribute    = "ttylogin"
+	ttyLoginDefaultValue = false
+	ttyLoginUCIOption    = "ttylogin"
 
 	uciConfig = "system"
 	uciType   = "system"
@@ -44,14 +51,16 @@ const (
 
 var (
 	conLogLevelSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       "The maximum log level for kernel messages to be logged to the console.",
+		Default:           int64default.StaticInt64(conLogLevelDefaultValue),
+		Description:       "The maximum log level for kernel messages to be logged to the console. Defaults to `7`.",
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetConLogLevel, conLogLevelAttribute, conLogLevelUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetConLogLevel, conLogLevelAttribute, conLogLevelUCIOption),
 	}
 
 	cronLogLevelSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       "The minimum level for cron messages to be logged to syslog.",
+		Default:           int64default.StaticInt64(cronLogLevelDefaultValue),
+		Description:       "The minimum level for cron messages to be logged to syslog. Defaults to `5`.",
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetCronLogLevel, cronLogLevelAttribute, cronLogLevelUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetCronLogLevel, cronLogLevelAttribute, cronLogLevelUCIOption),
@@ -72,7 +81,8 @@ var (
 	}
 
 	logSizeSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       "Size of the file based log buffer in KiB.",
+		Default:           int64default.StaticInt64(logSizeDefaultValue),
+		Description:       "Size of the file based log buffer in KiB. Defaults to `64`.",
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetLogSize, logSizeAttribute, logSizeUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetLogSize, logSizeAttribute, logSizeUCIOption),
@@ -86,16 +96,17 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		conLogLevelAttribute:    conLogLevelSchemaAttribute,
-		cronLogLevelAttribute:   cronLogLevelSchemaAttribute,
-		descriptionAttribute:    descriptionSchemaAttribute,
-		hostnameAttribute:       hostnameSchemaAttribute,
-		logSizeAttribute:        logSizeSchemaAttribute,
-		notesAttribute:          notesSchemaAttribute,
-		timezoneAttribute:       timezoneSchemaAttribute,
-		ttyLoginAttribute:       ttyLoginSchemaAttribute,
-		zonenameAttribute:       zonenameSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		conLogLevelAttribute:              conLogLevelSchemaAttribute,
+		cronLogLevelAttribute:             cronLogLevelSchemaAttribute,
+		descriptionAttribute:              descriptionSchemaAttribute,
+		hostnameAttribute:                 hostnameSchemaAttribute,
+		logSizeAttribute:                  logSizeSchemaAttribute,
+		notesAttribute:                    notesSchemaAttribute,
+		timezoneAttribute:                 timezoneSchemaAttribute,
+		ttyLoginAttribute:                 ttyLoginSchemaAttribute,
+		zonenameAttribute:                 zonenameSchemaAttribute,
 	}
 
 	timezoneSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
@@ -106,7 +117,8 @@ var (
 	}
 
 	ttyLoginSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       "Require authentication for local users to log in the system.",
+		Default:           booldefault.StaticBool(ttyLoginDefaultValue),
+		Description:       "Require authentication for local users to log in the system. Defaults to `false`.",
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetTTYLogin, ttyLoginAttribute, ttyLoginUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetTTYLogin, ttyLoginAttribute, ttyLoginUCIOption),
@@ -132,9 +144,12 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		nil,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
@@ -144,6 +159,7 @@ type model struct {
 	ConLogLevel  types.Int64  `tfsdk:"conloglevel"`
 	CronLogLevel types.Int64  `tfsdk:"cronloglevel"`
 	Description  types.String `tfsdk:"description"`
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
 	Hostname     types.String `tfsdk:"hostname"`
 	Id           types.String `tfsdk:"id"`
 	LogSize      types.Int64  `tfsdk:"log_size"`
@@ -156,6 +172,7 @@ type model struct {
 func modelGetConLogLevel(m model) types.Int64  { return m.ConLogLevel }
 func modelGetCronLogLevel(m model) types.Int64 { return m.CronLogLevel }
 func modelGetDescription(m model) types.String { return m.Description }
+func modelGetExtraOptions(m model) types.Map   { return m.ExtraOptions }
 func modelGetHostname(m model) types.String    { return m.Hostname }
 func modelGetId(m model) types.String          { return m.Id }
 func modelGetLogSize(m model) types.Int64      { return m.LogSize }
@@ -167,6 +184,7 @@ func modelGetZonename(m model) types.String    { return m.Zonename }
 func modelSetConLogLevel(m *model, value types.Int64)  { m.ConLogLevel = value }
 func modelSetCronLogLevel(m *model, value types.Int64) { m.CronLogLevel = value }
 func modelSetDescription(m *model, value types.String) { m.Description = value }
+func modelSetExtraOptions(m *model, value types.Map)   { m.ExtraOptions = value }
 func modelSetHostname(m *model, value types.String)    { m.Hostname = value }
 func modelSetId(m *model, value types.String)          { m.Id = value }
 func modelSetLogSize(m *model, value types.Int64)      { m.LogSize = value }
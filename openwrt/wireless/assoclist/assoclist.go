@@ -0,0 +1,264 @@
+package assoclist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	deviceAttribute            = "device"
+	deviceAttributeDescription = "Name of the wireless interface (e.g. \"wlan0\")."
+
+	idAttribute            = "id"
+	idAttributeDescription = "Same as `device`."
+
+	rateMCSAttribute            = "mcs"
+	rateMCSAttributeDescription = "The MCS index of the rate."
+
+	rateMHzAttribute            = "mhz"
+	rateMHzAttributeDescription = "The channel width of the rate, in MHz."
+
+	ratePacketsAttribute            = "packets"
+	ratePacketsAttributeDescription = "The number of packets in this direction."
+
+	rateRateAttribute            = "rate"
+	rateRateAttributeDescription = "The bitrate, in kbit/s."
+
+	schemaDescription = "The stations associated with a wireless interface, as reported by iwinfo."
+
+	stationsAttribute            = "stations"
+	stationsAttributeDescription = "Stations associated with the wireless interface."
+
+	stationsInactiveAttribute            = "inactive"
+	stationsInactiveAttributeDescription = "Time since the station was last active, in milliseconds."
+
+	stationsMACAddressAttribute            = "mac"
+	stationsMACAddressAttributeDescription = "MAC address of the station."
+
+	stationsNoiseAttribute            = "noise"
+	stationsNoiseAttributeDescription = "The noise floor, in dBm."
+
+	stationsRXAttribute            = "rx"
+	stationsRXAttributeDescription = "The rate the device receives from the station."
+
+	stationsSignalAttribute            = "signal"
+	stationsSignalAttributeDescription = "The signal strength of the station, in dBm."
+
+	stationsTXAttribute            = "tx"
+	stationsTXAttributeDescription = "The rate the device transmits to the station."
+
+	typeName = "wireless_assoclist"
+)
+
+var (
+	_ datasource.DataSource              = &dataSource{}
+	_ datasource.DataSourceWithConfigure = &dataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &dataSource{}
+}
+
+type dataSource struct {
+	client       lucirpc.Client
+	fullTypeName string
+}
+
+type model struct {
+	Device   types.String   `tfsdk:"device"`
+	Id       types.String   `tfsdk:"id"`
+	Stations []stationModel `tfsdk:"stations"`
+}
+
+type rateModel struct {
+	MCS     types.Int64 `tfsdk:"mcs"`
+	MHz     types.Int64 `tfsdk:"mhz"`
+	Packets types.Int64 `tfsdk:"packets"`
+	Rate    types.Int64 `tfsdk:"rate"`
+}
+
+type stationModel struct {
+	Inactive   types.Int64  `tfsdk:"inactive"`
+	MACAddress types.String `tfsdk:"mac"`
+	Noise      types.Int64  `tfsdk:"noise"`
+	RX         rateModel    `tfsdk:"rx"`
+	Signal     types.Int64  `tfsdk:"signal"`
+	TX         rateModel    `tfsdk:"tx"`
+}
+
+// Configure prepares the data source.
+func (d *dataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	res *datasource.ConfigureResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Configuring %s data source", typeName))
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "No provider data")
+		return
+	}
+
+	providerData, diagnostics := lucirpcglue.ParseProviderData(lucirpcglue.ConfigureRequest(req))
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = providerData.Client
+	d.fullTypeName = fmt.Sprintf("%s_%s", providerData.TypeName, typeName)
+}
+
+// Metadata sets the data source name.
+func (d *dataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	res *datasource.MetadataResponse,
+) {
+	d.fullTypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, typeName)
+	res.TypeName = d.fullTypeName
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	res *datasource.ReadResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s data source", d.fullTypeName))
+
+	tflog.Debug(ctx, "Retrieving values from config")
+	var config model
+	diagnostics := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	device := config.Device.ValueString()
+	config.Id = config.Device
+	tflog.Debug(ctx, fmt.Sprintf("Retrieving stations associated with %s", device))
+	stations, err := d.client.ListWirelessStations(ctx, device)
+	if err != nil {
+		res.Diagnostics.AddError(
+			fmt.Sprintf("problem listing stations associated with %s", device),
+			err.Error(),
+		)
+		return
+	}
+
+	config.Stations = []stationModel{}
+	for _, station := range stations {
+		config.Stations = append(config.Stations, stationModel{
+			Inactive:   types.Int64Value(station.Inactive),
+			MACAddress: types.StringValue(station.MAC),
+			Noise:      types.Int64Value(station.Noise),
+			RX:         newRateModel(station.RX),
+			Signal:     types.Int64Value(station.Signal),
+			TX:         newRateModel(station.TX),
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting the %s data source state", d.fullTypeName))
+	diagnostics = res.State.Set(ctx, config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	res *datasource.SchemaResponse,
+) {
+	res.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			deviceAttribute: schema.StringAttribute{
+				Description: deviceAttributeDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			idAttribute: schema.StringAttribute{
+				Computed:    true,
+				Description: idAttributeDescription,
+			},
+			stationsAttribute: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: stationsAttributeDescription,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						stationsInactiveAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: stationsInactiveAttributeDescription,
+						},
+						stationsMACAddressAttribute: schema.StringAttribute{
+							Computed:    true,
+							Description: stationsMACAddressAttributeDescription,
+						},
+						stationsNoiseAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: stationsNoiseAttributeDescription,
+						},
+						stationsRXAttribute: rateSchemaAttribute(stationsRXAttributeDescription),
+						stationsSignalAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: stationsSignalAttributeDescription,
+						},
+						stationsTXAttribute: rateSchemaAttribute(stationsTXAttributeDescription),
+					},
+				},
+			},
+		},
+		Description: schemaDescription,
+	}
+}
+
+func newRateModel(
+	rate lucirpc.WirelessRate,
+) rateModel {
+	return rateModel{
+		MCS:     types.Int64Value(rate.MCS),
+		MHz:     types.Int64Value(rate.MHz),
+		Packets: types.Int64Value(rate.Packets),
+		Rate:    types.Int64Value(rate.Rate),
+	}
+}
+
+func rateSchemaAttribute(
+	description string,
+) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			rateMCSAttribute: schema.Int64Attribute{
+				Computed:    true,
+				Description: rateMCSAttributeDescription,
+			},
+			rateMHzAttribute: schema.Int64Attribute{
+				Computed:    true,
+				Description: rateMHzAttributeDescription,
+			},
+			ratePacketsAttribute: schema.Int64Attribute{
+				Computed:    true,
+				Description: ratePacketsAttributeDescription,
+			},
+			rateRateAttribute: schema.Int64Attribute{
+				Computed:    true,
+				Description: rateRateAttributeDescription,
+			},
+		},
+		Computed:    true,
+		Description: description,
+	}
+}
@@ -0,0 +1,140 @@
+//go:build acceptance.test
+
+package assoclist_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	standInServer := acceptancetest.RunStandInServer(
+		t,
+		func(object string, method string, arguments map[string]any) string {
+			switch {
+			case object == "iwinfo" && method == "assoclist" && arguments["device"] == "wlan0":
+				return `[
+					0,
+					{
+						"results": [
+							{
+								"mac": "00:11:22:33:44:AA",
+								"signal": -45,
+								"noise": -95,
+								"inactive": 10,
+								"rx": {
+									"rate": 866700,
+									"mcs": 9,
+									"mhz": 80,
+									"packets": 1024
+								},
+								"tx": {
+									"rate": 780000,
+									"mcs": 8,
+									"mhz": 80,
+									"packets": 2048
+								}
+							},
+							{
+								"mac": "00:11:22:33:44:BB",
+								"signal": -70,
+								"noise": -95,
+								"inactive": 2000,
+								"rx": {
+									"rate": 6000,
+									"mcs": 0,
+									"mhz": 20,
+									"packets": 16
+								},
+								"tx": {
+									"rate": 1000,
+									"mcs": 0,
+									"mhz": 20,
+									"packets": 32
+								}
+							}
+						]
+					}
+				]`
+
+			case object == "iwinfo" && method == "assoclist" && arguments["device"] == "wlan1":
+				return `[0, {"results": []}]`
+
+			default:
+				return `[4]`
+			}
+		},
+	)
+	providerBlock := standInServer.ProviderBlock()
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_assoclist" "testing" {
+	device = "wlan0"
+}
+
+data "openwrt_wireless_assoclist" "empty" {
+	device = "wlan1"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "id", "wlan0"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.inactive", "10"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.mac", "00:11:22:33:44:AA"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.noise", "-95"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.rx.mcs", "9"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.rx.mhz", "80"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.rx.packets", "1024"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.rx.rate", "866700"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.signal", "-45"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.0.tx.rate", "780000"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.1.mac", "00:11:22:33:44:BB"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.1.signal", "-70"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.testing", "stations.1.tx.mhz", "20"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.empty", "id", "wlan1"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_assoclist.empty", "stations.#", "0"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestDataSourceUnknownDeviceAcceptance(t *testing.T) {
+	standInServer := acceptancetest.RunStandInServer(
+		t,
+		func(object string, method string, arguments map[string]any) string {
+			return `[4]`
+		},
+	)
+	providerBlock := standInServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_assoclist" "testing" {
+	device = "wlan9"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("problem listing stations associated with wlan9"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
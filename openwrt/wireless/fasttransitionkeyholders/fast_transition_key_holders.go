@@ -0,0 +1,204 @@
+package fasttransitionkeyholders
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	accessPointsAttribute            = "access_points"
+	accessPointsAttributeDescription = "Access points of the mobility domain. Every access point should be configured with the same `r0kh` and `r1kh`."
+
+	accessPointsMACAddressAttribute            = "mac"
+	accessPointsMACAddressAttributeDescription = `MAC address (BSSID) of the wireless network on the access point (e.g. "00:11:22:33:44:55"). This is also used as its R1 key holder id.`
+
+	accessPointsNASIdentifierAttribute            = "nasid"
+	accessPointsNASIdentifierAttributeDescription = "NAS-Identifier of the wireless network on the access point. This should match its `nasid`."
+
+	idAttribute            = "id"
+	idAttributeDescription = "Comma-separated MAC addresses of the access points."
+
+	keyAttribute            = "key"
+	keyAttributeDescription = "Key shared by the key holders. Must be 32 or 64 hexadecimal characters."
+
+	r0khAttribute            = "r0kh"
+	r0khAttributeDescription = "R0 key holders for the `r0kh` of each access point."
+
+	r1khAttribute            = "r1kh"
+	r1khAttributeDescription = "R1 key holders for the `r1kh` of each access point."
+
+	schemaDescription = "Derives the 802.11r key holder lists for several access points of the same mobility domain, so they are consistent with each other. Nothing is read from the device."
+
+	typeName = "wireless_fast_transition_key_holders"
+)
+
+var (
+	_ datasource.DataSource = &dataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &dataSource{}
+}
+
+type accessPointModel struct {
+	MACAddress    types.String `tfsdk:"mac"`
+	NASIdentifier types.String `tfsdk:"nasid"`
+}
+
+type dataSource struct {
+	fullTypeName string
+}
+
+type model struct {
+	AccessPoints types.List   `tfsdk:"access_points"`
+	Id           types.String `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+	R0KH         types.List   `tfsdk:"r0kh"`
+	R1KH         types.List   `tfsdk:"r1kh"`
+}
+
+// Metadata sets the data source name.
+func (d *dataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	res *datasource.MetadataResponse,
+) {
+	d.fullTypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, typeName)
+	res.TypeName = d.fullTypeName
+}
+
+// Read derives the key holder lists from the configured access points.
+// It does not talk to the device.
+func (d *dataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	res *datasource.ReadResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s data source", d.fullTypeName))
+
+	tflog.Debug(ctx, "Retrieving values from config")
+	var config model
+	diagnostics := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	var accessPoints []accessPointModel
+	diagnostics = config.AccessPoints.ElementsAs(ctx, &accessPoints, false)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	key := strings.ToLower(config.Key.ValueString())
+	macAddresses := []string{}
+	r0kh := []attr.Value{}
+	r1kh := []attr.Value{}
+	for _, accessPoint := range accessPoints {
+		macAddress := strings.ToLower(accessPoint.MACAddress.ValueString())
+		macAddresses = append(macAddresses, macAddress)
+		nasIdentifier := accessPoint.NASIdentifier.ValueString()
+		r0kh = append(r0kh, types.StringValue(fmt.Sprintf("%s,%s,%s", macAddress, nasIdentifier, key)))
+		r1kh = append(r1kh, types.StringValue(fmt.Sprintf("%s,%s,%s", macAddress, macAddress, key)))
+	}
+
+	config.Id = types.StringValue(strings.Join(macAddresses, ","))
+	config.R0KH, diagnostics = types.ListValue(types.StringType, r0kh)
+	res.Diagnostics.Append(diagnostics...)
+	config.R1KH, diagnostics = types.ListValue(types.StringType, r1kh)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting the %s data source state", d.fullTypeName))
+	diagnostics = res.State.Set(ctx, config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	res *datasource.SchemaResponse,
+) {
+	res.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			accessPointsAttribute: schema.ListNestedAttribute{
+				Description: accessPointsAttributeDescription,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						accessPointsMACAddressAttribute: schema.StringAttribute{
+							Description: accessPointsMACAddressAttributeDescription,
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]]$"),
+									`must be a valid MAC address (e.g. "00:11:22:33:44:55")`,
+								),
+							},
+						},
+						accessPointsNASIdentifierAttribute: schema.StringAttribute{
+							Description: accessPointsNASIdentifierAttributeDescription,
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 48),
+								stringvalidator.RegexMatches(
+									regexp.MustCompile("^[^,]+$"),
+									"must not contain a comma",
+								),
+							},
+						},
+					},
+				},
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			idAttribute: schema.StringAttribute{
+				Computed:    true,
+				Description: idAttributeDescription,
+			},
+			keyAttribute: schema.StringAttribute{
+				Description: keyAttributeDescription,
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^([[:xdigit:]]{32}|[[:xdigit:]]{64})$"),
+						"must be 32 or 64 hexadecimal characters",
+					),
+				},
+			},
+			r0khAttribute: schema.ListAttribute{
+				Computed:    true,
+				Description: r0khAttributeDescription,
+				ElementType: types.StringType,
+				Sensitive:   true,
+			},
+			r1khAttribute: schema.ListAttribute{
+				Computed:    true,
+				Description: r1khAttributeDescription,
+				ElementType: types.StringType,
+				Sensitive:   true,
+			},
+		},
+		Description: schemaDescription,
+	}
+}
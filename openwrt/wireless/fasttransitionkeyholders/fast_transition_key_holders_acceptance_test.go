@@ -0,0 +1,93 @@
+//go:build acceptance.test
+
+package fasttransitionkeyholders_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_fast_transition_key_holders" "testing" {
+	access_points = [
+		{
+			mac = "00:11:22:33:44:55"
+			nasid = "ap1"
+		},
+		{
+			mac = "00:11:22:33:44:AA"
+			nasid = "ap2"
+		},
+	]
+	key = "00112233445566778899AABBCCDDEEFF"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "id", "00:11:22:33:44:55,00:11:22:33:44:aa"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "r0kh.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "r0kh.0", "00:11:22:33:44:55,ap1,00112233445566778899aabbccddeeff"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "r0kh.1", "00:11:22:33:44:aa,ap2,00112233445566778899aabbccddeeff"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "r1kh.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "r1kh.0", "00:11:22:33:44:55,00:11:22:33:44:55,00112233445566778899aabbccddeeff"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_fast_transition_key_holders.testing", "r1kh.1", "00:11:22:33:44:aa,00:11:22:33:44:aa,00112233445566778899aabbccddeeff"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestDataSourceInvalidKeyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	openWrtServer := acceptancetest.RunOpenWrtServer(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	providerBlock := openWrtServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_fast_transition_key_holders" "testing" {
+	access_points = [
+		{
+			mac = "00:11:22:33:44:55"
+			nasid = "ap1"
+		},
+	]
+	key = "not-a-key"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
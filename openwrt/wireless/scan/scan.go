@@ -0,0 +1,297 @@
+package scan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	deviceAttribute            = "device"
+	deviceAttributeDescription = "Name of the wireless interface to scan with (e.g. \"wlan0\")."
+
+	idAttribute            = "id"
+	idAttributeDescription = "Same as `device`."
+
+	encryptionAuthenticationAttribute            = "authentication"
+	encryptionAuthenticationAttributeDescription = "Authentication suites of the network (e.g. \"psk\", \"sae\", \"802.1x\")."
+
+	encryptionCiphersAttribute            = "ciphers"
+	encryptionCiphersAttributeDescription = "Ciphers of the network (e.g. \"ccmp\", \"tkip\")."
+
+	encryptionEnabledAttribute            = "enabled"
+	encryptionEnabledAttributeDescription = "Whether the network is encrypted."
+
+	encryptionWPAAttribute            = "wpa"
+	encryptionWPAAttributeDescription = "WPA versions of the network."
+
+	networksAttribute            = "networks"
+	networksAttributeDescription = "Wireless networks within range of the wireless interface."
+
+	networksBSSIDAttribute            = "bssid"
+	networksBSSIDAttributeDescription = "BSSID of the network."
+
+	networksChannelAttribute            = "channel"
+	networksChannelAttributeDescription = "The channel the network is operating on."
+
+	networksEncryptionAttribute            = "encryption"
+	networksEncryptionAttributeDescription = "The encryption of the network."
+
+	networksModeAttribute            = "mode"
+	networksModeAttributeDescription = "The mode of the network (e.g. \"Master\", \"Ad-Hoc\", \"Mesh Point\")."
+
+	networksQualityAttribute            = "quality"
+	networksQualityAttributeDescription = "The link quality of the network, out of `quality_max`."
+
+	networksQualityMaxAttribute            = "quality_max"
+	networksQualityMaxAttributeDescription = "The maximum link quality."
+
+	networksSignalAttribute            = "signal"
+	networksSignalAttributeDescription = "The signal strength of the network, in dBm."
+
+	networksSSIDAttribute            = "ssid"
+	networksSSIDAttributeDescription = "SSID of the network. Empty if the network is hidden."
+
+	schemaDescription = "Wireless networks near the device, as reported by an iwinfo scan. Scanning can take a few seconds, and may briefly interrupt traffic on the wireless interface."
+
+	typeName = "wireless_scan"
+)
+
+var (
+	_ datasource.DataSource              = &dataSource{}
+	_ datasource.DataSourceWithConfigure = &dataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &dataSource{}
+}
+
+type dataSource struct {
+	client       lucirpc.Client
+	fullTypeName string
+}
+
+type encryptionModel struct {
+	Authentication []types.String `tfsdk:"authentication"`
+	Ciphers        []types.String `tfsdk:"ciphers"`
+	Enabled        types.Bool     `tfsdk:"enabled"`
+	WPA            []types.Int64  `tfsdk:"wpa"`
+}
+
+type model struct {
+	Device   types.String   `tfsdk:"device"`
+	Id       types.String   `tfsdk:"id"`
+	Networks []networkModel `tfsdk:"networks"`
+}
+
+type networkModel struct {
+	BSSID      types.String    `tfsdk:"bssid"`
+	Channel    types.Int64     `tfsdk:"channel"`
+	Encryption encryptionModel `tfsdk:"encryption"`
+	Mode       types.String    `tfsdk:"mode"`
+	Quality    types.Int64     `tfsdk:"quality"`
+	QualityMax types.Int64     `tfsdk:"quality_max"`
+	Signal     types.Int64     `tfsdk:"signal"`
+	SSID       types.String    `tfsdk:"ssid"`
+}
+
+// Configure prepares the data source.
+func (d *dataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	res *datasource.ConfigureResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Configuring %s data source", typeName))
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "No provider data")
+		return
+	}
+
+	providerData, diagnostics := lucirpcglue.ParseProviderData(lucirpcglue.ConfigureRequest(req))
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = providerData.Client
+	d.fullTypeName = fmt.Sprintf("%s_%s", providerData.TypeName, typeName)
+}
+
+// Metadata sets the data source name.
+func (d *dataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	res *datasource.MetadataResponse,
+) {
+	d.fullTypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, typeName)
+	res.TypeName = d.fullTypeName
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	res *datasource.ReadResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s data source", d.fullTypeName))
+
+	tflog.Debug(ctx, "Retrieving values from config")
+	var config model
+	diagnostics := req.Config.Get(ctx, &config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	device := config.Device.ValueString()
+	config.Id = config.Device
+	tflog.Debug(ctx, fmt.Sprintf("Scanning for wireless networks with %s", device))
+	networks, err := d.client.ScanWirelessNetworks(ctx, device)
+	if err != nil {
+		res.Diagnostics.AddError(
+			fmt.Sprintf("problem scanning for wireless networks with %s", device),
+			err.Error(),
+		)
+		return
+	}
+
+	config.Networks = []networkModel{}
+	for _, network := range networks {
+		config.Networks = append(config.Networks, networkModel{
+			BSSID:      types.StringValue(network.BSSID),
+			Channel:    types.Int64Value(network.Channel),
+			Encryption: newEncryptionModel(network.Encryption),
+			Mode:       types.StringValue(network.Mode),
+			Quality:    types.Int64Value(network.Quality),
+			QualityMax: types.Int64Value(network.QualityMax),
+			Signal:     types.Int64Value(network.Signal),
+			SSID:       types.StringValue(network.SSID),
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting the %s data source state", d.fullTypeName))
+	diagnostics = res.State.Set(ctx, config)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	res *datasource.SchemaResponse,
+) {
+	res.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			deviceAttribute: schema.StringAttribute{
+				Description: deviceAttributeDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			idAttribute: schema.StringAttribute{
+				Computed:    true,
+				Description: idAttributeDescription,
+			},
+			networksAttribute: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: networksAttributeDescription,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						networksBSSIDAttribute: schema.StringAttribute{
+							Computed:    true,
+							Description: networksBSSIDAttributeDescription,
+						},
+						networksChannelAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: networksChannelAttributeDescription,
+						},
+						networksEncryptionAttribute: schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								encryptionAuthenticationAttribute: schema.ListAttribute{
+									Computed:    true,
+									Description: encryptionAuthenticationAttributeDescription,
+									ElementType: types.StringType,
+								},
+								encryptionCiphersAttribute: schema.ListAttribute{
+									Computed:    true,
+									Description: encryptionCiphersAttributeDescription,
+									ElementType: types.StringType,
+								},
+								encryptionEnabledAttribute: schema.BoolAttribute{
+									Computed:    true,
+									Description: encryptionEnabledAttributeDescription,
+								},
+								encryptionWPAAttribute: schema.ListAttribute{
+									Computed:    true,
+									Description: encryptionWPAAttributeDescription,
+									ElementType: types.Int64Type,
+								},
+							},
+							Computed:    true,
+							Description: networksEncryptionAttributeDescription,
+						},
+						networksModeAttribute: schema.StringAttribute{
+							Computed:    true,
+							Description: networksModeAttributeDescription,
+						},
+						networksQualityAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: networksQualityAttributeDescription,
+						},
+						networksQualityMaxAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: networksQualityMaxAttributeDescription,
+						},
+						networksSignalAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: networksSignalAttributeDescription,
+						},
+						networksSSIDAttribute: schema.StringAttribute{
+							Computed:    true,
+							Description: networksSSIDAttributeDescription,
+						},
+					},
+				},
+			},
+		},
+		Description: schemaDescription,
+	}
+}
+
+func newEncryptionModel(
+	encryption lucirpc.WirelessEncryption,
+) encryptionModel {
+	authentication := []types.String{}
+	for _, suite := range encryption.Authentication {
+		authentication = append(authentication, types.StringValue(suite))
+	}
+
+	ciphers := []types.String{}
+	for _, cipher := range encryption.Ciphers {
+		ciphers = append(ciphers, types.StringValue(cipher))
+	}
+
+	wpa := []types.Int64{}
+	for _, version := range encryption.WPA {
+		wpa = append(wpa, types.Int64Value(int64(version)))
+	}
+
+	return encryptionModel{
+		Authentication: authentication,
+		Ciphers:        ciphers,
+		Enabled:        types.BoolValue(encryption.Enabled),
+		WPA:            wpa,
+	}
+}
@@ -0,0 +1,130 @@
+//go:build acceptance.test
+
+package scan_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	standInServer := acceptancetest.RunStandInServer(
+		t,
+		func(object string, method string, arguments map[string]any) string {
+			switch {
+			case object == "iwinfo" && method == "scan" && arguments["device"] == "wlan0":
+				return `[
+					0,
+					{
+						"results": [
+							{
+								"ssid": "Neighbor",
+								"bssid": "00:11:22:33:44:BB",
+								"mode": "Master",
+								"channel": 6,
+								"signal": -70,
+								"quality": 40,
+								"quality_max": 70,
+								"encryption": {
+									"enabled": true,
+									"wpa": [2, 3],
+									"authentication": ["psk", "sae"],
+									"ciphers": ["ccmp"]
+								}
+							},
+							{
+								"bssid": "00:11:22:33:44:CC",
+								"mode": "Master",
+								"channel": 11,
+								"signal": -85,
+								"quality": 25,
+								"quality_max": 70,
+								"encryption": {
+									"enabled": false
+								}
+							}
+						]
+					}
+				]`
+
+			default:
+				return `[4]`
+			}
+		},
+	)
+	providerBlock := standInServer.ProviderBlock()
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_scan" "testing" {
+	device = "wlan0"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "id", "wlan0"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.bssid", "00:11:22:33:44:BB"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.channel", "6"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.authentication.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.authentication.0", "psk"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.authentication.1", "sae"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.ciphers.#", "1"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.ciphers.0", "ccmp"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.enabled", "true"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.wpa.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.wpa.0", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.encryption.wpa.1", "3"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.mode", "Master"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.quality", "40"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.quality_max", "70"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.signal", "-70"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.0.ssid", "Neighbor"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.1.bssid", "00:11:22:33:44:CC"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.1.encryption.authentication.#", "0"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.1.encryption.enabled", "false"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.1.encryption.wpa.#", "0"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_scan.testing", "networks.1.ssid", ""),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestDataSourceUnknownDeviceAcceptance(t *testing.T) {
+	standInServer := acceptancetest.RunStandInServer(
+		t,
+		func(object string, method string, arguments map[string]any) string {
+			return `[4]`
+		},
+	)
+	providerBlock := standInServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_scan" "testing" {
+	device = "wlan9"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("problem scanning for wireless networks with wlan9"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,232 @@
+package status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	idAttribute            = "id"
+	idAttributeDescription = "Placeholder identifier. It is always \"wireless\", as there is only one wireless status per device."
+	idValue                = "wireless"
+
+	radiosAttribute            = "radios"
+	radiosAttributeDescription = "Radios on the device, keyed by the name of their `wifi-device` section (e.g. \"radio0\")."
+
+	radiosChannelAttribute            = "channel"
+	radiosChannelAttributeDescription = "The channel the radio is operating on. Unset if the radio has no active wireless interfaces."
+
+	radiosDisabledAttribute            = "disabled"
+	radiosDisabledAttributeDescription = "Whether the radio is disabled in its configuration."
+
+	radiosInterfacesAttribute            = "interfaces"
+	radiosInterfacesAttributeDescription = "Names of the active wireless interfaces on the radio (e.g. \"wlan0\")."
+
+	radiosNoiseAttribute            = "noise"
+	radiosNoiseAttributeDescription = "The noise floor of the radio, in dBm. Unset if the radio has no active wireless interfaces."
+
+	radiosPendingAttribute            = "pending"
+	radiosPendingAttributeDescription = "Whether the radio is in the process of being brought up or down."
+
+	radiosTXPowerAttribute            = "txpower"
+	radiosTXPowerAttributeDescription = "The transmit power of the radio, in dBm. Unset if the radio has no active wireless interfaces."
+
+	radiosUpAttribute            = "up"
+	radiosUpAttributeDescription = "Whether the radio is up."
+
+	schemaDescription = "The current state of the radios on the device, as reported by netifd and iwinfo."
+
+	typeName = "wireless_status"
+)
+
+var (
+	_ datasource.DataSource              = &dataSource{}
+	_ datasource.DataSourceWithConfigure = &dataSource{}
+)
+
+func NewDataSource() datasource.DataSource {
+	return &dataSource{}
+}
+
+type dataSource struct {
+	client       lucirpc.Client
+	fullTypeName string
+}
+
+type model struct {
+	Id     types.String          `tfsdk:"id"`
+	Radios map[string]radioModel `tfsdk:"radios"`
+}
+
+type radioModel struct {
+	Channel    types.Int64    `tfsdk:"channel"`
+	Disabled   types.Bool     `tfsdk:"disabled"`
+	Interfaces []types.String `tfsdk:"interfaces"`
+	Noise      types.Int64    `tfsdk:"noise"`
+	Pending    types.Bool     `tfsdk:"pending"`
+	TXPower    types.Int64    `tfsdk:"txpower"`
+	Up         types.Bool     `tfsdk:"up"`
+}
+
+// Configure prepares the data source.
+func (d *dataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	res *datasource.ConfigureResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Configuring %s data source", typeName))
+	if req.ProviderData == nil {
+		tflog.Debug(ctx, "No provider data")
+		return
+	}
+
+	providerData, diagnostics := lucirpcglue.ParseProviderData(lucirpcglue.ConfigureRequest(req))
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = providerData.Client
+	d.fullTypeName = fmt.Sprintf("%s_%s", providerData.TypeName, typeName)
+}
+
+// Metadata sets the data source name.
+func (d *dataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	res *datasource.MetadataResponse,
+) {
+	d.fullTypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, typeName)
+	res.TypeName = d.fullTypeName
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	res *datasource.ReadResponse,
+) {
+	tflog.Info(ctx, fmt.Sprintf("Reading %s data source", d.fullTypeName))
+
+	tflog.Debug(ctx, "Retrieving wireless status")
+	radios, err := d.client.GetWirelessStatus(ctx)
+	if err != nil {
+		res.Diagnostics.AddError(
+			"problem reading wireless status",
+			err.Error(),
+		)
+		return
+	}
+
+	state := model{
+		Id:     types.StringValue(idValue),
+		Radios: map[string]radioModel{},
+	}
+	for name, radio := range radios {
+		radioState := radioModel{
+			Channel:    types.Int64Null(),
+			Disabled:   types.BoolValue(radio.Disabled),
+			Interfaces: []types.String{},
+			Noise:      types.Int64Null(),
+			Pending:    types.BoolValue(radio.Pending),
+			TXPower:    types.Int64Null(),
+			Up:         types.BoolValue(radio.Up),
+		}
+		for _, radioInterface := range radio.Interfaces {
+			if radioInterface.Ifname == "" {
+				continue
+			}
+
+			radioState.Interfaces = append(radioState.Interfaces, types.StringValue(radioInterface.Ifname))
+		}
+
+		// Every interface on a radio shares its channel, noise, and txpower,
+		// so it is enough to ask about the first one.
+		if len(radioState.Interfaces) > 0 {
+			ifname := radioState.Interfaces[0].ValueString()
+			tflog.Debug(ctx, fmt.Sprintf("Retrieving wireless info for %s", ifname))
+			info, err := d.client.GetWirelessInfo(ctx, ifname)
+			if err != nil {
+				res.Diagnostics.AddError(
+					fmt.Sprintf("problem reading wireless info for radio %s", name),
+					err.Error(),
+				)
+				return
+			}
+
+			radioState.Channel = types.Int64Value(info.Channel)
+			radioState.Noise = types.Int64Value(info.Noise)
+			radioState.TXPower = types.Int64Value(info.TXPower)
+		}
+
+		state.Radios[name] = radioState
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting the %s data source state", d.fullTypeName))
+	diagnostics := res.State.Set(ctx, state)
+	res.Diagnostics.Append(diagnostics...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *dataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	res *datasource.SchemaResponse,
+) {
+	res.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			idAttribute: schema.StringAttribute{
+				Computed:    true,
+				Description: idAttributeDescription,
+			},
+			radiosAttribute: schema.MapNestedAttribute{
+				Computed:    true,
+				Description: radiosAttributeDescription,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						radiosChannelAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: radiosChannelAttributeDescription,
+						},
+						radiosDisabledAttribute: schema.BoolAttribute{
+							Computed:    true,
+							Description: radiosDisabledAttributeDescription,
+						},
+						radiosInterfacesAttribute: schema.ListAttribute{
+							Computed:    true,
+							Description: radiosInterfacesAttributeDescription,
+							ElementType: types.StringType,
+						},
+						radiosNoiseAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: radiosNoiseAttributeDescription,
+						},
+						radiosPendingAttribute: schema.BoolAttribute{
+							Computed:    true,
+							Description: radiosPendingAttributeDescription,
+						},
+						radiosTXPowerAttribute: schema.Int64Attribute{
+							Computed:    true,
+							Description: radiosTXPowerAttributeDescription,
+						},
+						radiosUpAttribute: schema.BoolAttribute{
+							Computed:    true,
+							Description: radiosUpAttributeDescription,
+						},
+					},
+				},
+			},
+		},
+		Description: schemaDescription,
+	}
+}
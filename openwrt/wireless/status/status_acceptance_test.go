@@ -0,0 +1,134 @@
+//go:build acceptance.test
+
+package status_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	standInServer := acceptancetest.RunStandInServer(
+		t,
+		func(object string, method string, arguments map[string]any) string {
+			switch {
+			case object == "network.wireless" && method == "status":
+				return `[
+					0,
+					{
+						"radio0": {
+							"up": true,
+							"pending": false,
+							"autostart": true,
+							"disabled": false,
+							"interfaces": [
+								{
+									"section": "default_radio0",
+									"ifname": "wlan0"
+								},
+								{
+									"section": "guest_radio0",
+									"ifname": "wlan0-1"
+								}
+							]
+						},
+						"radio1": {
+							"up": false,
+							"pending": false,
+							"autostart": false,
+							"disabled": true,
+							"interfaces": [
+								{
+									"section": "default_radio1"
+								}
+							]
+						}
+					}
+				]`
+
+			case object == "iwinfo" && method == "info" && arguments["device"] == "wlan0":
+				return `[
+					0,
+					{
+						"phy": "phy0",
+						"ssid": "OpenWrt",
+						"mode": "Master",
+						"channel": 36,
+						"frequency": 5180,
+						"txpower": 23,
+						"noise": -95
+					}
+				]`
+
+			default:
+				return `[3]`
+			}
+		},
+	)
+	providerBlock := standInServer.ProviderBlock()
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_status" "testing" {
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.%", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.channel", "36"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.disabled", "false"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.interfaces.#", "2"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.interfaces.0", "wlan0"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.interfaces.1", "wlan0-1"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.noise", "-95"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.pending", "false"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.txpower", "23"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio0.up", "true"),
+			resource.TestCheckNoResourceAttr("data.openwrt_wireless_status.testing", "radios.radio1.channel"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio1.disabled", "true"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio1.interfaces.#", "0"),
+			resource.TestCheckNoResourceAttr("data.openwrt_wireless_status.testing", "radios.radio1.noise"),
+			resource.TestCheckNoResourceAttr("data.openwrt_wireless_status.testing", "radios.radio1.txpower"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_status.testing", "radios.radio1.up", "false"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestDataSourceUbusFailureAcceptance(t *testing.T) {
+	standInServer := acceptancetest.RunStandInServer(
+		t,
+		func(object string, method string, arguments map[string]any) string {
+			return `[6]`
+		},
+	)
+	providerBlock := standInServer.ProviderBlock()
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_status" "testing" {
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("problem reading wireless status"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -1,26 +1,45 @@
 package wifidevice
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
 	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+	"golang.org/x/exp/slices"
 )
 
 const (
+	acsExcludeDFSAttribute            = "acs_exclude_dfs"
+	acsExcludeDFSAttributeDescription = "Exclude DFS channels when \"channel\" is \"auto\". Defaults to `false`."
+	acsExcludeDFSDefaultValue         = false
+	acsExcludeDFSUCIOption            = "acs_exclude_dfs"
+
 	bandAttribute            = "band"
-	bandAttributeDescription = `Channel width. Must be one of: "2g", "5g", "6g".`
+	bandAttributeDescription = "Frequency band of the radio. Must be one of: \"2g\", \"5g\", \"6g\". When set, `channel` and `htmode` are checked against the band."
 	band2G                   = "2g"
 	band5G                   = "5g"
 	band6G                   = "6g"
 	bandUCIOption            = "band"
 
+	beaconIntervalAttribute            = "beacon_int"
+	beaconIntervalAttributeDescription = "Interval between beacons, in time units (1.024 ms). Must be in the range: `[15, 65535]`. If unset, `100` is used."
+	beaconIntervalUCIOption            = "beacon_int"
+
 	cellDensityAttribute            = "cell_density"
-	cellDensityAttributeDescription = "Configures data rates based on the coverage cell density. Must be one of 0, 1, 2, 3."
+	cellDensityAttributeDescription = "Configures data rates based on the coverage cell density. Must be one of 0, 1, 2, 3. Defaults to `0`."
+	cellDensityDefaultValue         = 0
 	cellDensityDisabled             = 0
 	cellDensityHigh                 = 2
 	cellDensityNormal               = 1
@@ -28,16 +47,29 @@ const (
 	cellDensityVeryHigh             = 3
 
 	channelAttribute            = "channel"
-	channelAttributeDescription = `The wireless channel. Currently, only "auto" is supported.`
+	channelAttributeDescription = `The wireless channel. Either "auto", or a channel number in the band of the radio: 1-14 for "2g", 36-64, 100-144, or 149-177 (in steps of 4) for "5g", and 1-233 (in steps of 4) or 2 for "6g". When "band" is unset, a channel of any band is allowed.`
 	channelAuto                 = "auto"
 	channelUCIOption            = "channel"
 
+	channelsAttribute            = "channels"
+	channelsAttributeDescription = `Channels that may be picked when "channel" is "auto". Each entry is either a channel (e.g. "36") or a range of channels (e.g. "36-48").`
+	channelsUCIOption            = "channels"
+
 	countryCodeAttribute            = "country"
 	countryCodeAttributeDescription = `Two-digit country code. E.g. "US".`
 	countryCodeUCIOption            = "country"
 
+	disabledAttribute            = "disabled"
+	disabledAttributeDescription = "Disables the radio, and every wireless network on it. Defaults to `false`."
+	disabledDefaultValue         = false
+	disabledUCIOption            = "disabled"
+
+	heBSSColorAttribute            = "he_bss_color"
+	heBSSColorAttributeDescription = "BSS color of 802.11ax networks, which lets clients tell overlapping networks on the same channel apart. Must be in the range: `[1, 63]`. Only used with an \"HE\" `htmode`. If unset, a color is picked at random."
+	heBSSColorUCIOption            = "he_bss_color"
+
 	htModeAttribute            = "htmode"
-	htModeAttributeDescription = `Channel width. Must be one of: "HE20", "HE40", "HE80", "HE160", "HT20", "HT40", "HT40-", "HT40+", "NONE", "VHT20", "VHT40", "VHT80", "VHT160".`
+	htModeAttributeDescription = `Channel width. Must be one of: "HE20", "HE40", "HE80", "HE160", "HT20", "HT40", "HT40-", "HT40+", "NONE", "VHT20", "VHT40", "VHT80", "VHT160". When "band" is "2g", the "VHT" modes, "HE80", and "HE160" are not allowed. When "band" is "6g", only the "HE" modes are allowed.`
 	htModeHE160                = "HE160"
 	htModeHE20                 = "HE20"
 	htModeHE40                 = "HE40"
@@ -53,11 +85,35 @@ const (
 	htModeVHT40                = "VHT40"
 	htModeVHT80                = "VHT80"
 
+	legacyRatesAttribute            = "legacy_rates"
+	legacyRatesAttributeDescription = "Allow the legacy 802.11b data rates (1, 2, 5.5, and 11 Mbps). Only used when \"band\" is \"2g\". Defaults to `false`."
+	legacyRatesDefaultValue         = false
+	legacyRatesUCIOption            = "legacy_rates"
+
+	logLevelAttribute            = "log_level"
+	logLevelAttributeDescription = "Verbosity of the hostapd log. Must be one of 0 (verbose debugging), 1 (debugging), 2 (informational), 3 (notification), 4 (warning). If unset, `2` is used."
+	logLevelDebug                = 1
+	logLevelInformational        = 2
+	logLevelNotification         = 3
+	logLevelUCIOption            = "log_level"
+	logLevelVerbose              = 0
+	logLevelWarning              = 4
+
+	noScanAttribute            = "noscan"
+	noScanAttributeDescription = "Do not scan for overlapping networks before using a 40 MHz channel. This can interfere with neighboring networks. Defaults to `false`."
+	noScanDefaultValue         = false
+	noScanUCIOption            = "noscan"
+
 	pathAttribute            = "path"
 	pathAttributeDescription = "Path of the device in `/sys/devices`."
 	pathUCIOption            = "path"
 
 	schemaDescription = "The physical radio device."
+	schemaVersion     = 0
+
+	txPowerAttribute            = "txpower"
+	txPowerAttributeDescription = "Transmit power, in dBm. Must be at least `0`. The power is still capped by the regulatory limits of the `country`. If unset, the maximum allowed power is used."
+	txPowerUCIOption            = "txpower"
 
 	typeAttribute            = "type"
 	typeAttributeDescription = `The type of device. Currently only "mac80211" is supported.`
@@ -69,6 +125,20 @@ const (
 )
 
 var (
+	acsExcludeDFSSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(acsExcludeDFSDefaultValue),
+		Description:       acsExcludeDFSAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetACSExcludeDFS, acsExcludeDFSAttribute, acsExcludeDFSUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetACSExcludeDFS, acsExcludeDFSAttribute, acsExcludeDFSUCIOption),
+		Validators: []validator.Bool{
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(channelAttribute),
+				channelAuto,
+			),
+		},
+	}
+
 	bandSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       bandAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetBand, bandAttribute, bandUCIOption),
@@ -83,7 +153,18 @@ var (
 		},
 	}
 
+	beaconIntervalSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       beaconIntervalAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetBeaconInterval, beaconIntervalAttribute, beaconIntervalUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetBeaconInterval, beaconIntervalAttribute, beaconIntervalUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(15, 65535),
+		},
+	}
+
 	cellDensitySchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           int64default.StaticInt64(cellDensityDefaultValue),
 		Description:       cellDensityAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetCellDensity, cellDensityAttribute, cellDensityUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -105,11 +186,121 @@ var (
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetChannel, channelAttribute, channelUCIOption),
 		Validators: []validator.String{
 			stringvalidator.OneOf(
+				channelsAnyBand...,
+			),
+		},
+	}
+
+	channelsSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       channelsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetChannels, channelsAttribute, channelsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetChannels, channelsAttribute, channelsUCIOption),
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^[[:digit:]]{1,3}(-[[:digit:]]{1,3})?$"),
+					`must be a channel (e.g. "36") or a range of channels (e.g. "36-48")`,
+				),
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(channelAttribute),
 				channelAuto,
 			),
 		},
 	}
 
+	channels2G = []string{
+		channelAuto,
+		"1", "2", "3", "4", "5", "6", "7", "8", "9", "10",
+		"11", "12", "13", "14",
+	}
+
+	channels5G = []string{
+		channelAuto,
+		"36", "40", "44", "48", "52", "56", "60", "64", "100", "104",
+		"108", "112", "116", "120", "124", "128", "132", "136", "140", "144",
+		"149", "153", "157", "161", "165", "169", "173", "177",
+	}
+
+	channels6G = []string{
+		channelAuto,
+		"1", "2", "5", "9", "13", "17", "21", "25", "29", "33",
+		"37", "41", "45", "49", "53", "57", "61", "65", "69", "73",
+		"77", "81", "85", "89", "93", "97", "101", "105", "109", "113",
+		"117", "121", "125", "129", "133", "137", "141", "145", "149", "153",
+		"157", "161", "165", "169", "173", "177", "181", "185", "189", "193",
+		"197", "201", "205", "209", "213", "217", "221", "225", "229", "233",
+	}
+
+	// channelsAnyBand checks `channel` when `band` is unset,
+	// since the channel could belong to any of the bands.
+	channelsAnyBand = unionChannels(
+		channels2G,
+		channels5G,
+		channels6G,
+	)
+
+	configValidators = []resource.ConfigValidator{
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(bandAttribute),
+			band2G,
+			lucirpcglue.AttributeOneOfString(
+				path.MatchRoot(channelAttribute),
+				channels2G...,
+			),
+			lucirpcglue.AttributeOneOfString(
+				path.MatchRoot(htModeAttribute),
+				htModeHE20,
+				htModeHE40,
+				htModeHT20,
+				htModeHT40,
+				htModeHT40Minus,
+				htModeHT40Plus,
+				htModeNone,
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(bandAttribute),
+			band5G,
+			lucirpcglue.AttributeOneOfString(
+				path.MatchRoot(channelAttribute),
+				channels5G...,
+			),
+			lucirpcglue.AttributeOneOfString(
+				path.MatchRoot(htModeAttribute),
+				htModeHE160,
+				htModeHE20,
+				htModeHE40,
+				htModeHE80,
+				htModeHT20,
+				htModeHT40,
+				htModeHT40Minus,
+				htModeHT40Plus,
+				htModeNone,
+				htModeVHT160,
+				htModeVHT20,
+				htModeVHT40,
+				htModeVHT80,
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(bandAttribute),
+			band6G,
+			lucirpcglue.AttributeOneOfString(
+				path.MatchRoot(channelAttribute),
+				channels6G...,
+			),
+			lucirpcglue.AttributeOneOfString(
+				path.MatchRoot(htModeAttribute),
+				htModeHE160,
+				htModeHE20,
+				htModeHE40,
+				htModeHE80,
+			),
+		),
+	}
+
 	countryCodeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       countryCodeAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetCountryCode, countryCodeAttribute, countryCodeUCIOption),
@@ -120,6 +311,42 @@ var (
 		},
 	}
 
+	disabledSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(disabledDefaultValue),
+		Description:       disabledAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetDisabled, disabledAttribute, disabledUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetDisabled, disabledAttribute, disabledUCIOption),
+	}
+
+	heBSSColorSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       heBSSColorAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetHEBSSColor, heBSSColorAttribute, heBSSColorUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetHEBSSColor, heBSSColorAttribute, heBSSColorUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 63),
+			int64validator.AnyWithAllWarnings(
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(htModeAttribute),
+					htModeHE160,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(htModeAttribute),
+					htModeHE20,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(htModeAttribute),
+					htModeHE40,
+				),
+				lucirpcglue.RequiresAttributeEqualString(
+					path.MatchRoot(htModeAttribute),
+					htModeHE80,
+				),
+			),
+		},
+	}
+
 	htModeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       htModeAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetHTMode, htModeAttribute, htModeUCIOption),
@@ -144,6 +371,44 @@ var (
 		},
 	}
 
+	legacyRatesSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(legacyRatesDefaultValue),
+		Description:       legacyRatesAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetLegacyRates, legacyRatesAttribute, legacyRatesUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetLegacyRates, legacyRatesAttribute, legacyRatesUCIOption),
+		Validators: []validator.Bool{
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(bandAttribute),
+				band2G,
+			),
+		},
+	}
+
+	logLevelSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       logLevelAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetLogLevel, logLevelAttribute, logLevelUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetLogLevel, logLevelAttribute, logLevelUCIOption),
+		Validators: []validator.Int64{
+			int64validator.OneOf(
+				logLevelVerbose,
+				logLevelDebug,
+				logLevelInformational,
+				logLevelNotification,
+				logLevelWarning,
+			),
+		},
+	}
+
+	noScanSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(noScanDefaultValue),
+		Description:       noScanAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetNoScan, noScanAttribute, noScanUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetNoScan, noScanAttribute, noScanUCIOption),
+	}
+
 	pathSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       pathAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetPath, pathAttribute, pathUCIOption),
@@ -152,18 +417,41 @@ var (
 	}
 
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		bandAttribute:           bandSchemaAttribute,
-		cellDensityAttribute:    cellDensitySchemaAttribute,
-		channelAttribute:        channelSchemaAttribute,
-		countryCodeAttribute:    countryCodeSchemaAttribute,
-		lucirpcglue.IdAttribute: lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		htModeAttribute:         htModeSchemaAttribute,
-		pathAttribute:           pathSchemaAttribute,
-		typeAttribute:           typeSchemaAttribute,
+		acsExcludeDFSAttribute:            acsExcludeDFSSchemaAttribute,
+		bandAttribute:                     bandSchemaAttribute,
+		beaconIntervalAttribute:           beaconIntervalSchemaAttribute,
+		cellDensityAttribute:              cellDensitySchemaAttribute,
+		channelAttribute:                  channelSchemaAttribute,
+		channelsAttribute:                 channelsSchemaAttribute,
+		countryCodeAttribute:              countryCodeSchemaAttribute,
+		disabledAttribute:                 disabledSchemaAttribute,
+		heBSSColorAttribute:               heBSSColorSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		htModeAttribute:                   htModeSchemaAttribute,
+		legacyRatesAttribute:              legacyRatesSchemaAttribute,
+		logLevelAttribute:                 logLevelSchemaAttribute,
+		noScanAttribute:                   noScanSchemaAttribute,
+		pathAttribute:                     pathSchemaAttribute,
+		txPowerAttribute:                  txPowerSchemaAttribute,
+		typeAttribute:                     typeSchemaAttribute,
+	}
+
+	txPowerSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       txPowerAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetTXPower, txPowerAttribute, txPowerUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetTXPower, txPowerAttribute, txPowerUCIOption),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
 	}
 
 	typeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		Description:       typeAttributeDescription,
+		Description: typeAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetType, typeAttribute, typeUCIOption),
 		ResourceExistence: lucirpcglue.Required,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetType, typeAttribute, typeUCIOption),
@@ -187,39 +475,88 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		configValidators,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
-	Band        types.String `tfsdk:"band"`
-	CellDensity types.Int64  `tfsdk:"cell_density"`
-	Channel     types.String `tfsdk:"channel"`
-	CountryCode types.String `tfsdk:"country"`
-	HTMode      types.String `tfsdk:"htmode"`
-	Id          types.String `tfsdk:"id"`
-	Path        types.String `tfsdk:"path"`
-	Type        types.String `tfsdk:"type"`
+	ACSExcludeDFS  types.Bool   `tfsdk:"acs_exclude_dfs"`
+	Band           types.String `tfsdk:"band"`
+	BeaconInterval types.Int64  `tfsdk:"beacon_int"`
+	CellDensity    types.Int64  `tfsdk:"cell_density"`
+	Channel        types.String `tfsdk:"channel"`
+	Channels       types.List   `tfsdk:"channels"`
+	CountryCode    types.String `tfsdk:"country"`
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	ExtraOptions   types.Map    `tfsdk:"extra_options"`
+	HEBSSColor     types.Int64  `tfsdk:"he_bss_color"`
+	HTMode         types.String `tfsdk:"htmode"`
+	Id             types.String `tfsdk:"id"`
+	LegacyRates    types.Bool   `tfsdk:"legacy_rates"`
+	LogLevel       types.Int64  `tfsdk:"log_level"`
+	NoScan         types.Bool   `tfsdk:"noscan"`
+	Path           types.String `tfsdk:"path"`
+	TXPower        types.Int64  `tfsdk:"txpower"`
+	Type           types.String `tfsdk:"type"`
 }
 
-func modelGetBand(m model) types.String        { return m.Band }
-func modelGetCellDensity(m model) types.Int64  { return m.CellDensity }
-func modelGetChannel(m model) types.String     { return m.Channel }
-func modelGetCountryCode(m model) types.String { return m.CountryCode }
-func modelGetHTMode(m model) types.String      { return m.HTMode }
-func modelGetId(m model) types.String          { return m.Id }
-func modelGetPath(m model) types.String        { return m.Path }
-func modelGetType(m model) types.String        { return m.Type }
-
-func modelSetBand(m *model, value types.String)        { m.Band = value }
-func modelSetCellDensity(m *model, value types.Int64)  { m.CellDensity = value }
-func modelSetChannel(m *model, value types.String)     { m.Channel = value }
-func modelSetCountryCode(m *model, value types.String) { m.CountryCode = value }
-func modelSetHTMode(m *model, value types.String)      { m.HTMode = value }
-func modelSetId(m *model, value types.String)          { m.Id = value }
-func modelSetPath(m *model, value types.String)        { m.Path = value }
-func modelSetType(m *model, value types.String)        { m.Type = value }
+func modelGetACSExcludeDFS(m model) types.Bool   { return m.ACSExcludeDFS }
+func modelGetBand(m model) types.String          { return m.Band }
+func modelGetBeaconInterval(m model) types.Int64 { return m.BeaconInterval }
+func modelGetCellDensity(m model) types.Int64    { return m.CellDensity }
+func modelGetChannel(m model) types.String       { return m.Channel }
+func modelGetChannels(m model) types.List        { return m.Channels }
+func modelGetCountryCode(m model) types.String   { return m.CountryCode }
+func modelGetDisabled(m model) types.Bool        { return m.Disabled }
+func modelGetExtraOptions(m model) types.Map     { return m.ExtraOptions }
+func modelGetHEBSSColor(m model) types.Int64     { return m.HEBSSColor }
+func modelGetHTMode(m model) types.String        { return m.HTMode }
+func modelGetId(m model) types.String            { return m.Id }
+func modelGetLegacyRates(m model) types.Bool     { return m.LegacyRates }
+func modelGetLogLevel(m model) types.Int64       { return m.LogLevel }
+func modelGetNoScan(m model) types.Bool          { return m.NoScan }
+func modelGetPath(m model) types.String          { return m.Path }
+func modelGetTXPower(m model) types.Int64        { return m.TXPower }
+func modelGetType(m model) types.String          { return m.Type }
+
+func modelSetACSExcludeDFS(m *model, value types.Bool)   { m.ACSExcludeDFS = value }
+func modelSetBand(m *model, value types.String)          { m.Band = value }
+func modelSetBeaconInterval(m *model, value types.Int64) { m.BeaconInterval = value }
+func modelSetCellDensity(m *model, value types.Int64)    { m.CellDensity = value }
+func modelSetChannel(m *model, value types.String)       { m.Channel = value }
+func modelSetChannels(m *model, value types.List)        { m.Channels = value }
+func modelSetCountryCode(m *model, value types.String)   { m.CountryCode = value }
+func modelSetDisabled(m *model, value types.Bool)        { m.Disabled = value }
+func modelSetExtraOptions(m *model, value types.Map)     { m.ExtraOptions = value }
+func modelSetHEBSSColor(m *model, value types.Int64)     { m.HEBSSColor = value }
+func modelSetHTMode(m *model, value types.String)        { m.HTMode = value }
+func modelSetId(m *model, value types.String)            { m.Id = value }
+func modelSetLegacyRates(m *model, value types.Bool)     { m.LegacyRates = value }
+func modelSetLogLevel(m *model, value types.Int64)       { m.LogLevel = value }
+func modelSetNoScan(m *model, value types.Bool)          { m.NoScan = value }
+func modelSetPath(m *model, value types.String)          { m.Path = value }
+func modelSetTXPower(m *model, value types.Int64)        { m.TXPower = value }
+func modelSetType(m *model, value types.String)          { m.Type = value }
+
+// unionChannels combines the channels of several bands, keeping the first occurrence of each channel.
+func unionChannels(
+	channelLists ...[]string,
+) []string {
+	union := []string{}
+	for _, channels := range channelLists {
+		for _, channel := range channels {
+			if !slices.Contains(union, channel) {
+				union = append(union, channel)
+			}
+		}
+	}
+
+	return union
+}
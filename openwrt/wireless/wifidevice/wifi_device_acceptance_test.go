@@ -5,6 +5,7 @@ package wifidevice_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -110,3 +111,181 @@ resource "openwrt_wireless_wifi_device" "testing" {
 		updateAndReadResource,
 	)
 }
+
+func TestResourceChannelNotInBandAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_device" "testing" {
+	band = "2g"
+	channel = "36"
+	id = "testing"
+	type = "mac80211"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceChannelNotInAnyBandAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_device" "testing" {
+	channel = "999"
+	id = "testing"
+	type = "mac80211"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceHTModeNotInBandAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_device" "testing" {
+	band = "6g"
+	channel = "auto"
+	htmode = "VHT80"
+	id = "testing"
+	type = "mac80211"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceRadioOptionsAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_device" "testing" {
+	band = "5g"
+	beacon_int = 200
+	channel = "36"
+	disabled = true
+	htmode = "VHT80"
+	id = "testing"
+	log_level = 1
+	noscan = true
+	txpower = 20
+	type = "mac80211"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "band", "5g"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "beacon_int", "200"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "channel", "36"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "disabled", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "htmode", "VHT80"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "log_level", "1"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "noscan", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "txpower", "20"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_device.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_device" "testing" {
+	acs_exclude_dfs = true
+	band = "2g"
+	channel = "auto"
+	channels = [
+		"1",
+		"6",
+		"11",
+	]
+	he_bss_color = 42
+	htmode = "HE20"
+	id = "testing"
+	legacy_rates = true
+	type = "mac80211"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "acs_exclude_dfs", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "band", "2g"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "channel", "auto"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "channels.#", "3"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "channels.2", "11"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "he_bss_color", "42"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "htmode", "HE20"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_device.testing", "legacy_rates", "true"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_device.testing", "txpower"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
@@ -1,10 +1,16 @@
 package wifiiface
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
@@ -12,12 +18,53 @@ import (
 )
 
 const (
+	accountingPortAttribute            = "acct_port"
+	accountingPortAttributeDescription = "Port of the RADIUS accounting server. Must be in the range: `[1, 65535]`. If unset, `1813` is used."
+	accountingPortUCIOption            = "acct_port"
+
+	accountingSecretAttribute            = "acct_secret"
+	accountingSecretAttributeDescription = "Shared secret of the RADIUS accounting server."
+	accountingSecretUCIOption            = "acct_secret"
+
+	accountingServerAttribute            = "acct_server"
+	accountingServerAttributeDescription = "Address of the RADIUS accounting server. If unset, accounting is disabled."
+	accountingServerUCIOption            = "acct_server"
+
+	authenticationPortAttribute            = "auth_port"
+	authenticationPortAttributeDescription = "Port of the RADIUS authentication server. Must be in the range: `[1, 65535]`. If unset, `1812` is used."
+	authenticationPortUCIOption            = "auth_port"
+
+	authenticationSecretAttribute            = "auth_secret"
+	authenticationSecretAttributeDescription = "Shared secret of the RADIUS authentication server. Required for WPA-Enterprise encryption methods."
+	authenticationSecretUCIOption            = "auth_secret"
+
+	authenticationServerAttribute            = "auth_server"
+	authenticationServerAttributeDescription = `Address of the RADIUS authentication server (e.g. "192.0.2.10"). Required for WPA-Enterprise encryption methods: "wpa", "wpa2", "wpa3", "wpa3-mixed".`
+	authenticationServerUCIOption            = "auth_server"
+
+	bssidAttribute            = "bssid"
+	bssidAttributeDescription = `MAC address of the access point to connect to (e.g. "00:11:22:33:44:55"). Only used when "mode" is "sta". If unset, any access point with the SSID is used.`
+	bssidUCIOption            = "bssid"
+
+	bssTransitionAttribute            = "bss_transition"
+	bssTransitionAttributeDescription = "Allow the access point to steer clients to a better access point (802.11v BSS Transition Management). Defaults to `false`."
+	bssTransitionDefaultValue         = false
+	bssTransitionUCIOption            = "bss_transition"
+
 	deviceAttribute            = "device"
 	deviceAttributeDescription = "Name of the physical device. This name is what the device is known as in LuCI/UCI, or the `id` field in Terraform."
 	deviceUCIOption            = "device"
 
+	dynamicVLANAttribute            = "dynamic_vlan"
+	dynamicVLANAttributeDescription = "Assign clients to the VLAN given by the RADIUS server. Must be one of 0 (disabled), 1 (use the VLAN if the RADIUS server gives one), 2 (reject clients the RADIUS server gives no VLAN). If unset, `0` is used."
+	dynamicVLANDisabled             = 0
+	dynamicVLANOptional             = 1
+	dynamicVLANRequired             = 2
+	dynamicVLANUCIOption            = "dynamic_vlan"
+
 	encryptionMethodAttribute            = "encryption"
-	encryptionMethodAttributeDescription = `Encryption method. Currently, only PSK encryption methods are supported. Must be one of: "none", "psk", "psk2", "psk2+aes", "psk2+ccmp", "psk2+tkip", "psk2+tkip+aes", "psk2+tkip+ccmp", "psk+aes", "psk+ccmp", "psk-mixed", "psk-mixed+aes", "psk-mixed+ccmp", "psk-mixed+tkip", "psk-mixed+tkip+aes", "psk-mixed+tkip+ccmp", "psk+tkip", "psk+tkip+aes", "psk+tkip+ccmp", "sae", "sae-mixed".`
+	encryptionMethodAttributeDescription = `Encryption method. The PSK and SAE methods use a passphrase, and the WPA-Enterprise methods ("wpa", "wpa2", "wpa3", "wpa3-mixed") authenticate clients against a RADIUS server. Must be one of: "none", "psk", "psk2", "psk2+aes", "psk2+ccmp", "psk2+tkip", "psk2+tkip+aes", "psk2+tkip+ccmp", "psk+aes", "psk+ccmp", "psk-mixed", "psk-mixed+aes", "psk-mixed+ccmp", "psk-mixed+tkip", "psk-mixed+tkip+aes", "psk-mixed+tkip+ccmp", "psk+tkip", "psk+tkip+aes", "psk+tkip+ccmp", "sae", "sae-mixed", "wpa", "wpa2", "wpa3", "wpa3-mixed". Defaults to "none".`
+	encryptionMethodDefaultValue         = encryptionMethodNone
 	encryptionMethodNone                 = "none"
 	encryptionMethodPSK                  = "psk"
 	encryptionMethodPSK2                 = "psk2"
@@ -40,39 +87,239 @@ const (
 	encryptionMethodSAE                  = "sae"
 	encryptionMethodSAEMixed             = "sae-mixed"
 	encryptionMethodUCIOption            = "encryption"
+	encryptionMethodWPA                  = "wpa"
+	encryptionMethodWPA2                 = "wpa2"
+	encryptionMethodWPA3                 = "wpa3"
+	encryptionMethodWPA3Mixed            = "wpa3-mixed"
+
+	ftOverDSAttribute            = "ft_over_ds"
+	ftOverDSAttributeDescription = "Perform fast transitions over the wired network (distribution system) instead of over the air. Only used when `ieee80211r` is `true`."
+	ftOverDSUCIOption            = "ft_over_ds"
+
+	ftPSKGenerateLocalAttribute            = "ft_psk_generate_local"
+	ftPSKGenerateLocalAttributeDescription = "Derive the fast transition keys of a PSK network locally, instead of exchanging them between access points. With this, `r0kh` and `r1kh` are not needed. Only used when `ieee80211r` is `true`."
+	ftPSKGenerateLocalUCIOption            = "ft_psk_generate_local"
+
+	ieee80211kAttribute            = "ieee80211k"
+	ieee80211kAttributeDescription = "Enable 802.11k Radio Resource Management, so clients can ask for a report of neighboring access points. Defaults to `false`."
+	ieee80211kDefaultValue         = false
+	ieee80211kUCIOption            = "ieee80211k"
+
+	ieee80211rAttribute            = "ieee80211r"
+	ieee80211rAttributeDescription = "Enable 802.11r Fast BSS Transition, so clients can roam between access points of the same `mobility_domain` without a full reauthentication. Defaults to `false`."
+	ieee80211rDefaultValue         = false
+	ieee80211rUCIOption            = "ieee80211r"
+
+	ieee80211vAttribute            = "ieee80211v"
+	ieee80211vAttributeDescription = "Enable 802.11v Wireless Network Management (e.g. time advertisement and proxy ARP). Defaults to `false`."
+	ieee80211vDefaultValue         = false
+	ieee80211vUCIOption            = "ieee80211v"
 
 	isolateClientsAttribute            = "isolate"
-	isolateClientsAttributeDescription = "Isolate wireless clients from each other."
+	isolateClientsAttributeDescription = "Isolate wireless clients from each other. Defaults to `false`."
+	isolateClientsDefaultValue         = false
 	isolateClientsUCIOption            = "isolate"
 
 	keyAttribute            = "key"
-	keyAttributeDescription = "The pre-shared passphrase from which the pre-shared key will be derived. The clear text key has to be 8-63 characters long."
+	keyAttributeDescription = "The pre-shared passphrase from which the pre-shared key will be derived. The clear text key has to be 8-63 characters long. Only used with the PSK and SAE encryption methods."
 	keyUCIOption            = "key"
 
 	krackWorkaroundAttribute            = "wpa_disable_eapol_key_retries"
-	krackWorkaroundAttributeDescription = "Enable WPA key reinstallation attack (KRACK) workaround. This should be `true` to enable KRACK workaround (you almost surely want this enabled)."
+	krackWorkaroundAttributeDescription = "Enable WPA key reinstallation attack (KRACK) workaround. This should be `true` to enable KRACK workaround (you almost surely want this enabled). Defaults to `false`."
+	krackWorkaroundDefaultValue         = false
 	krackWorkaroundUCIOption            = "wpa_disable_eapol_key_retries"
 
+	meshForwardingAttribute            = "mesh_fwding"
+	meshForwardingAttributeDescription = "Forward packets between mesh peers at layer 2. Only used when \"mode\" is \"mesh\". If unset, `true` is used."
+	meshForwardingUCIOption            = "mesh_fwding"
+
+	meshIdAttribute            = "mesh_id"
+	meshIdAttributeDescription = `Identifier of the 802.11s mesh network. Every node of the mesh must use the same identifier. Required when "mode" is "mesh".`
+	meshIdUCIOption            = "mesh_id"
+
+	meshRSSIThresholdAttribute            = "mesh_rssi_threshold"
+	meshRSSIThresholdAttributeDescription = "Minimum signal strength (in dBm) of a mesh peer before a link is established with it. Must be in the range: `[-255, 0]`. A value of `0` disables the threshold. Only used when \"mode\" is \"mesh\"."
+	meshRSSIThresholdUCIOption            = "mesh_rssi_threshold"
+
+	mobilityDomainAttribute            = "mobility_domain"
+	mobilityDomainAttributeDescription = "Identifier shared by the access points that clients can fast transition between. Must be 4 hexadecimal characters (e.g. \"4f57\"). Only used when `ieee80211r` is `true`."
+	mobilityDomainUCIOption            = "mobility_domain"
+
 	modeAP                   = "ap"
 	modeAttribute            = "mode"
-	modeAttributeDescription = `The operation mode of the wireless network interface controller.. Currently only "ap" is supported.`
+	modeAttributeDescription = `The operation mode of the wireless network interface controller. Must be one of: "ap" (access point), "mesh" (802.11s mesh point), "monitor", "sta" (client).`
+	modeMesh                 = "mesh"
+	modeMonitor              = "monitor"
+	modeSTA                  = "sta"
 	modeUCIOption            = "mode"
 
+	nasIdentifierAttribute            = "nasid"
+	nasIdentifierAttributeDescription = "NAS-Identifier sent to the RADIUS server. With 802.11r, this is also the identifier of the R0 key holder of this access point, and should match its entry in `r0kh`."
+	nasIdentifierUCIOption            = "nasid"
+
 	networkAttribute            = "network"
 	networkAttributeDescription = "Network interface to attach the wireless network. This name is what the interface is known as in UCI, or the `id` field in Terraform."
 	networkUCIOption            = "network"
 
+	ownIPAddressAttribute            = "ownip"
+	ownIPAddressAttributeDescription = `NAS-IP-Address sent to the RADIUS server (e.g. "192.0.2.1").`
+	ownIPAddressUCIOption            = "ownip"
+
+	r0khAttribute            = "r0kh"
+	r0khAttributeDescription = "R0 key holders of the mobility domain. Each entry has the form \"<MAC address>,<NAS-Identifier>,<key>\", where the key is 32 or 64 hexadecimal characters shared by the access points. The `openwrt_wireless_fast_transition_key_holders` data source can derive consistent entries for several access points. Only used when `ieee80211r` is `true`."
+	r0khUCIOption            = "r0kh"
+
+	r1khAttribute            = "r1kh"
+	r1khAttributeDescription = "R1 key holders of the mobility domain. Each entry has the form \"<MAC address>,<R1 key holder id>,<key>\", where the R1 key holder id is formatted like a MAC address (usually the BSSID), and the key is 32 or 64 hexadecimal characters shared by the access points. Only used when `ieee80211r` is `true`."
+	r1khUCIOption            = "r1kh"
+
 	schemaDescription = "A wireless network."
+	schemaVersion     = 0
 
 	ssidAttribute            = "ssid"
-	ssidAttributeDescription = "The broadcasted SSID of the wireless network. This is what actual clients will see the network as."
+	ssidAttributeDescription = `The broadcasted SSID of the wireless network. This is what actual clients will see the network as. When "mode" is "sta", this is the SSID of the network to connect to. Required when "mode" is "ap" or "sta".`
 	ssidUCIOption            = "ssid"
 
+	wdsAttribute            = "wds"
+	wdsAttributeDescription = "Use 4-address (WDS) frames, so the connection can be part of a bridge. Only used when \"mode\" is \"ap\" or \"sta\". If unset, `false` is used."
+	wdsUCIOption            = "wds"
+
 	uciConfig = "wireless"
 	uciType   = "wifi-iface"
 )
 
 var (
+	accountingPortSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       accountingPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetAccountingPort, accountingPortAttribute, accountingPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetAccountingPort, accountingPortAttribute, accountingPortUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+			requiresEnterpriseEncryptionInt64,
+		},
+	}
+
+	accountingSecretSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       accountingSecretAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetAccountingSecret, accountingSecretAttribute, accountingSecretUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		Sensitive:         true,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetAccountingSecret, accountingSecretAttribute, accountingSecretUCIOption),
+		Validators: []validator.String{
+			requiresEnterpriseEncryptionString,
+		},
+	}
+
+	accountingServerSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       accountingServerAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetAccountingServer, accountingServerAttribute, accountingServerUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetAccountingServer, accountingServerAttribute, accountingServerUCIOption),
+		Validators: []validator.String{
+			requiresEnterpriseEncryptionString,
+		},
+	}
+
+	authenticationPortSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       authenticationPortAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetAuthenticationPort, authenticationPortAttribute, authenticationPortUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetAuthenticationPort, authenticationPortAttribute, authenticationPortUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 65535),
+			requiresEnterpriseEncryptionInt64,
+		},
+	}
+
+	authenticationSecretSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       authenticationSecretAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetAuthenticationSecret, authenticationSecretAttribute, authenticationSecretUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		Sensitive:         true,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetAuthenticationSecret, authenticationSecretAttribute, authenticationSecretUCIOption),
+		Validators: []validator.String{
+			requiresEnterpriseEncryptionString,
+		},
+	}
+
+	authenticationServerSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       authenticationServerAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetAuthenticationServer, authenticationServerAttribute, authenticationServerUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetAuthenticationServer, authenticationServerAttribute, authenticationServerUCIOption),
+		Validators: []validator.String{
+			requiresEnterpriseEncryptionString,
+		},
+	}
+
+	bssidSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       bssidAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetBSSID, bssidAttribute, bssidUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetBSSID, bssidAttribute, bssidUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]]$"),
+				`must be a valid MAC address (e.g. "00:11:22:33:44:55")`,
+			),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(modeAttribute),
+				modeSTA,
+			),
+		},
+	}
+
+	bssTransitionSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(bssTransitionDefaultValue),
+		Description:       bssTransitionAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetBSSTransition, bssTransitionAttribute, bssTransitionUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetBSSTransition, bssTransitionAttribute, bssTransitionUCIOption),
+	}
+
+	configValidators = append(
+		enterpriseEncryptionConfigValidators(enterpriseEncryptionMethods),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(encryptionMethodAttribute),
+			encryptionMethodNone,
+			lucirpcglue.NoneOf(
+				path.MatchRoot(keyAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(modeAttribute),
+			modeAP,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(ssidAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(modeAttribute),
+			modeMesh,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(meshIdAttribute),
+			),
+			lucirpcglue.NoneOf(
+				path.MatchRoot(wdsAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(modeAttribute),
+			modeMonitor,
+			lucirpcglue.NoneOf(
+				path.MatchRoot(wdsAttribute),
+			),
+		),
+		lucirpcglue.WhenAttributeEqualString(
+			path.MatchRoot(modeAttribute),
+			modeSTA,
+			lucirpcglue.AtLeastOneOf(
+				path.MatchRoot(ssidAttribute),
+			),
+		),
+	)
+
 	deviceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       deviceAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetDevice, deviceAttribute, deviceUCIOption),
@@ -80,7 +327,23 @@ var (
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetDevice, deviceAttribute, deviceUCIOption),
 	}
 
+	dynamicVLANSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       dynamicVLANAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetDynamicVLAN, dynamicVLANAttribute, dynamicVLANUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetDynamicVLAN, dynamicVLANAttribute, dynamicVLANUCIOption),
+		Validators: []validator.Int64{
+			int64validator.OneOf(
+				dynamicVLANDisabled,
+				dynamicVLANOptional,
+				dynamicVLANRequired,
+			),
+			requiresEnterpriseEncryptionInt64,
+		},
+	}
+
 	encryptionMethodSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           stringdefault.StaticString(encryptionMethodDefaultValue),
 		Description:       encryptionMethodAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetEncryptionMethod, encryptionMethodAttribute, encryptionMethodUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -108,11 +371,74 @@ var (
 				encryptionMethodPSKTKIPCCMP,
 				encryptionMethodSAE,
 				encryptionMethodSAEMixed,
+				encryptionMethodWPA,
+				encryptionMethodWPA2,
+				encryptionMethodWPA3,
+				encryptionMethodWPA3Mixed,
 			),
 		},
 	}
 
+	// enterpriseEncryptionMethods are the WPA-Enterprise encryption methods, which authenticate clients against a RADIUS server.
+	enterpriseEncryptionMethods = []string{
+		encryptionMethodWPA,
+		encryptionMethodWPA2,
+		encryptionMethodWPA3,
+		encryptionMethodWPA3Mixed,
+	}
+
+	ftOverDSSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ftOverDSAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetFTOverDS, ftOverDSAttribute, ftOverDSUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetFTOverDS, ftOverDSAttribute, ftOverDSUCIOption),
+		Validators: []validator.Bool{
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(ieee80211rAttribute),
+				true,
+			),
+		},
+	}
+
+	ftPSKGenerateLocalSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ftPSKGenerateLocalAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetFTPSKGenerateLocal, ftPSKGenerateLocalAttribute, ftPSKGenerateLocalUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetFTPSKGenerateLocal, ftPSKGenerateLocalAttribute, ftPSKGenerateLocalUCIOption),
+		Validators: []validator.Bool{
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(ieee80211rAttribute),
+				true,
+			),
+		},
+	}
+
+	ieee80211kSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(ieee80211kDefaultValue),
+		Description:       ieee80211kAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetIEEE80211K, ieee80211kAttribute, ieee80211kUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetIEEE80211K, ieee80211kAttribute, ieee80211kUCIOption),
+	}
+
+	ieee80211rSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(ieee80211rDefaultValue),
+		Description:       ieee80211rAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetIEEE80211R, ieee80211rAttribute, ieee80211rUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetIEEE80211R, ieee80211rAttribute, ieee80211rUCIOption),
+	}
+
+	ieee80211vSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(ieee80211vDefaultValue),
+		Description:       ieee80211vAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetIEEE80211V, ieee80211vAttribute, ieee80211vUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetIEEE80211V, ieee80211vAttribute, ieee80211vUCIOption),
+	}
+
 	isolateClientsSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(isolateClientsDefaultValue),
 		Description:       isolateClientsAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetIsolateClients, isolateClientsAttribute, isolateClientsUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -138,6 +464,7 @@ var (
 	}
 
 	krackWorkaroundSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Default:           booldefault.StaticBool(krackWorkaroundDefaultValue),
 		Description:       krackWorkaroundAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetKRACKWorkaround, krackWorkaroundAttribute, krackWorkaroundUCIOption),
 		ResourceExistence: lucirpcglue.NoValidation,
@@ -150,6 +477,63 @@ var (
 		},
 	}
 
+	meshForwardingSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       meshForwardingAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetMeshForwarding, meshForwardingAttribute, meshForwardingUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetMeshForwarding, meshForwardingAttribute, meshForwardingUCIOption),
+		Validators: []validator.Bool{
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(modeAttribute),
+				modeMesh,
+			),
+		},
+	}
+
+	meshIdSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       meshIdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMeshId, meshIdAttribute, meshIdUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetMeshId, meshIdAttribute, meshIdUCIOption),
+		Validators: []validator.String{
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(modeAttribute),
+				modeMesh,
+			),
+		},
+	}
+
+	meshRSSIThresholdSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       meshRSSIThresholdAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetMeshRSSIThreshold, meshRSSIThresholdAttribute, meshRSSIThresholdUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetMeshRSSIThreshold, meshRSSIThresholdAttribute, meshRSSIThresholdUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(-255, 0),
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(modeAttribute),
+				modeMesh,
+			),
+		},
+	}
+
+	mobilityDomainSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       mobilityDomainAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMobilityDomain, mobilityDomainAttribute, mobilityDomainUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetMobilityDomain, mobilityDomainAttribute, mobilityDomainUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^[[:xdigit:]]{4}$"),
+				`must be 4 hexadecimal characters (e.g. "4f57")`,
+			),
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(ieee80211rAttribute),
+				true,
+			),
+		},
+	}
+
 	modeSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       modeAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMode, modeAttribute, modeUCIOption),
@@ -158,6 +542,27 @@ var (
 		Validators: []validator.String{
 			stringvalidator.OneOf(
 				modeAP,
+				modeMesh,
+				modeMonitor,
+				modeSTA,
+			),
+		},
+	}
+
+	nasIdentifierSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nasIdentifierAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetNASIdentifier, nasIdentifierAttribute, nasIdentifierUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetNASIdentifier, nasIdentifierAttribute, nasIdentifierUCIOption),
+		Validators: []validator.String{
+			stringvalidator.AnyWithAllWarnings(
+				append(
+					requiresEncryptionMethodString(enterpriseEncryptionMethods),
+					lucirpcglue.RequiresAttributeEqualBool(
+						path.MatchRoot(ieee80211rAttribute),
+						true,
+					),
+				)...,
 			),
 		},
 	}
@@ -169,24 +574,117 @@ var (
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetNetwork, networkAttribute, networkUCIOption),
 	}
 
+	ownIPAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ownIPAddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetOwnIPAddress, ownIPAddressAttribute, ownIPAddressUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetOwnIPAddress, ownIPAddressAttribute, ownIPAddressUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:digit:]]{1,3}.){3}[[:digit:]]{1,3}$"),
+				`must be a valid IPv4 address (e.g. "192.0.2.1")`,
+			),
+			requiresEnterpriseEncryptionString,
+		},
+	}
+
+	r0khSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       r0khAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetR0KH, r0khAttribute, r0khUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetR0KH, r0khAttribute, r0khUCIOption),
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]],[^,]+,([[:xdigit:]]{32}|[[:xdigit:]]{64})$"),
+					`must be of the form "<MAC address>,<NAS-Identifier>,<key>" (e.g. "00:11:22:33:44:55,ap1,00112233445566778899aabbccddeeff")`,
+				),
+			),
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(ieee80211rAttribute),
+				true,
+			),
+		},
+	}
+
+	r1khSchemaAttribute = lucirpcglue.ListStringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       r1khAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionListString(modelSetR1KH, r1khAttribute, r1khUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionListString(modelGetR1KH, r1khAttribute, r1khUCIOption),
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]],([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]],([[:xdigit:]]{32}|[[:xdigit:]]{64})$"),
+					`must be of the form "<MAC address>,<R1 key holder id>,<key>" (e.g. "00:11:22:33:44:55,00:11:22:33:44:55,00112233445566778899aabbccddeeff")`,
+				),
+			),
+			lucirpcglue.RequiresAttributeEqualBool(
+				path.MatchRoot(ieee80211rAttribute),
+				true,
+			),
+		},
+	}
+
+	// requiresEnterpriseEncryptionInt64 only allows an attribute with a WPA-Enterprise encryption method.
+	requiresEnterpriseEncryptionInt64 = int64validator.AnyWithAllWarnings(
+		requiresEncryptionMethodInt64(enterpriseEncryptionMethods)...,
+	)
+
+	// requiresEnterpriseEncryptionString only allows an attribute with a WPA-Enterprise encryption method.
+	requiresEnterpriseEncryptionString = stringvalidator.AnyWithAllWarnings(
+		requiresEncryptionMethodString(enterpriseEncryptionMethods)...,
+	)
+
 	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
-		deviceAttribute:           deviceSchemaAttribute,
-		encryptionMethodAttribute: encryptionMethodSchemaAttribute,
-		isolateClientsAttribute:   isolateClientsSchemaAttribute,
-		keyAttribute:              keySchemaAttribute,
-		krackWorkaroundAttribute:  krackWorkaroundSchemaAttribute,
-		lucirpcglue.IdAttribute:   lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
-		modeAttribute:             modeSchemaAttribute,
-		networkAttribute:          networkSchemaAttribute,
-		ssidAttribute:             ssidSchemaAttribute,
+		accountingPortAttribute:           accountingPortSchemaAttribute,
+		accountingSecretAttribute:         accountingSecretSchemaAttribute,
+		accountingServerAttribute:         accountingServerSchemaAttribute,
+		authenticationPortAttribute:       authenticationPortSchemaAttribute,
+		authenticationSecretAttribute:     authenticationSecretSchemaAttribute,
+		authenticationServerAttribute:     authenticationServerSchemaAttribute,
+		bssidAttribute:                    bssidSchemaAttribute,
+		bssTransitionAttribute:            bssTransitionSchemaAttribute,
+		deviceAttribute:                   deviceSchemaAttribute,
+		dynamicVLANAttribute:              dynamicVLANSchemaAttribute,
+		encryptionMethodAttribute:         encryptionMethodSchemaAttribute,
+		ftOverDSAttribute:                 ftOverDSSchemaAttribute,
+		ftPSKGenerateLocalAttribute:       ftPSKGenerateLocalSchemaAttribute,
+		ieee80211kAttribute:               ieee80211kSchemaAttribute,
+		ieee80211rAttribute:               ieee80211rSchemaAttribute,
+		ieee80211vAttribute:               ieee80211vSchemaAttribute,
+		isolateClientsAttribute:           isolateClientsSchemaAttribute,
+		keyAttribute:                      keySchemaAttribute,
+		krackWorkaroundAttribute:          krackWorkaroundSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.IdSchemaAttribute(modelGetId, modelSetId),
+		meshForwardingAttribute:           meshForwardingSchemaAttribute,
+		meshIdAttribute:                   meshIdSchemaAttribute,
+		meshRSSIThresholdAttribute:        meshRSSIThresholdSchemaAttribute,
+		mobilityDomainAttribute:           mobilityDomainSchemaAttribute,
+		modeAttribute:                     modeSchemaAttribute,
+		nasIdentifierAttribute:            nasIdentifierSchemaAttribute,
+		networkAttribute:                  networkSchemaAttribute,
+		ownIPAddressAttribute:             ownIPAddressSchemaAttribute,
+		r0khAttribute:                     r0khSchemaAttribute,
+		r1khAttribute:                     r1khSchemaAttribute,
+		ssidAttribute:                     ssidSchemaAttribute,
+		wdsAttribute:                      wdsSchemaAttribute,
 	}
 
 	ssidSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
 		Description:       ssidAttributeDescription,
 		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetSSID, ssidAttribute, ssidUCIOption),
-		ResourceExistence: lucirpcglue.Required,
+		ResourceExistence: lucirpcglue.NoValidation,
 		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetSSID, ssidAttribute, ssidUCIOption),
 	}
+
+	wdsSchemaAttribute = lucirpcglue.BoolSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       wdsAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionBool(modelSetWDS, wdsAttribute, wdsUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionBool(modelGetWDS, wdsAttribute, wdsUCIOption),
+	}
 )
 
 func NewDataSource() datasource.DataSource {
@@ -201,42 +699,182 @@ func NewDataSource() datasource.DataSource {
 
 func NewResource() resource.Resource {
 	return lucirpcglue.NewResource(
+		configValidators,
 		modelGetId,
 		schemaAttributes,
 		schemaDescription,
+		schemaVersion,
+		nil,
 		uciConfig,
 		uciType,
 	)
 }
 
 type model struct {
-	Device           types.String `tfsdk:"device"`
-	EncryptionMethod types.String `tfsdk:"encryption"`
-	Id               types.String `tfsdk:"id"`
-	IsolateClients   types.Bool   `tfsdk:"isolate"`
-	Key              types.String `tfsdk:"key"`
-	KRACKWorkaround  types.Bool   `tfsdk:"wpa_disable_eapol_key_retries"`
-	Mode             types.String `tfsdk:"mode"`
-	Network          types.String `tfsdk:"network"`
-	SSID             types.String `tfsdk:"ssid"`
+	AccountingPort       types.Int64  `tfsdk:"acct_port"`
+	AccountingSecret     types.String `tfsdk:"acct_secret"`
+	AccountingServer     types.String `tfsdk:"acct_server"`
+	AuthenticationPort   types.Int64  `tfsdk:"auth_port"`
+	AuthenticationSecret types.String `tfsdk:"auth_secret"`
+	AuthenticationServer types.String `tfsdk:"auth_server"`
+	BSSID                types.String `tfsdk:"bssid"`
+	BSSTransition        types.Bool   `tfsdk:"bss_transition"`
+	Device               types.String `tfsdk:"device"`
+	DynamicVLAN          types.Int64  `tfsdk:"dynamic_vlan"`
+	EncryptionMethod     types.String `tfsdk:"encryption"`
+	ExtraOptions         types.Map    `tfsdk:"extra_options"`
+	FTOverDS             types.Bool   `tfsdk:"ft_over_ds"`
+	FTPSKGenerateLocal   types.Bool   `tfsdk:"ft_psk_generate_local"`
+	Id                   types.String `tfsdk:"id"`
+	IEEE80211K           types.Bool   `tfsdk:"ieee80211k"`
+	IEEE80211R           types.Bool   `tfsdk:"ieee80211r"`
+	IEEE80211V           types.Bool   `tfsdk:"ieee80211v"`
+	IsolateClients       types.Bool   `tfsdk:"isolate"`
+	Key                  types.String `tfsdk:"key"`
+	KRACKWorkaround      types.Bool   `tfsdk:"wpa_disable_eapol_key_retries"`
+	MeshForwarding       types.Bool   `tfsdk:"mesh_fwding"`
+	MeshId               types.String `tfsdk:"mesh_id"`
+	MeshRSSIThreshold    types.Int64  `tfsdk:"mesh_rssi_threshold"`
+	MobilityDomain       types.String `tfsdk:"mobility_domain"`
+	Mode                 types.String `tfsdk:"mode"`
+	NASIdentifier        types.String `tfsdk:"nasid"`
+	Network              types.String `tfsdk:"network"`
+	OwnIPAddress         types.String `tfsdk:"ownip"`
+	R0KH                 types.List   `tfsdk:"r0kh"`
+	R1KH                 types.List   `tfsdk:"r1kh"`
+	SSID                 types.String `tfsdk:"ssid"`
+	WDS                  types.Bool   `tfsdk:"wds"`
+}
+
+func modelGetAccountingPort(m model) types.Int64        { return m.AccountingPort }
+func modelGetAccountingSecret(m model) types.String     { return m.AccountingSecret }
+func modelGetAccountingServer(m model) types.String     { return m.AccountingServer }
+func modelGetAuthenticationPort(m model) types.Int64    { return m.AuthenticationPort }
+func modelGetAuthenticationSecret(m model) types.String { return m.AuthenticationSecret }
+func modelGetAuthenticationServer(m model) types.String { return m.AuthenticationServer }
+func modelGetBSSID(m model) types.String                { return m.BSSID }
+func modelGetBSSTransition(m model) types.Bool          { return m.BSSTransition }
+func modelGetDevice(m model) types.String               { return m.Device }
+func modelGetDynamicVLAN(m model) types.Int64           { return m.DynamicVLAN }
+func modelGetEncryptionMethod(m model) types.String     { return m.EncryptionMethod }
+func modelGetExtraOptions(m model) types.Map            { return m.ExtraOptions }
+func modelGetFTOverDS(m model) types.Bool               { return m.FTOverDS }
+func modelGetFTPSKGenerateLocal(m model) types.Bool     { return m.FTPSKGenerateLocal }
+func modelGetId(m model) types.String                   { return m.Id }
+func modelGetIEEE80211K(m model) types.Bool             { return m.IEEE80211K }
+func modelGetIEEE80211R(m model) types.Bool             { return m.IEEE80211R }
+func modelGetIEEE80211V(m model) types.Bool             { return m.IEEE80211V }
+func modelGetIsolateClients(m model) types.Bool         { return m.IsolateClients }
+func modelGetKey(m model) types.String                  { return m.Key }
+func modelGetKRACKWorkaround(m model) types.Bool        { return m.KRACKWorkaround }
+func modelGetMeshForwarding(m model) types.Bool         { return m.MeshForwarding }
+func modelGetMeshId(m model) types.String               { return m.MeshId }
+func modelGetMeshRSSIThreshold(m model) types.Int64     { return m.MeshRSSIThreshold }
+func modelGetMobilityDomain(m model) types.String       { return m.MobilityDomain }
+func modelGetMode(m model) types.String                 { return m.Mode }
+func modelGetNASIdentifier(m model) types.String        { return m.NASIdentifier }
+func modelGetNetwork(m model) types.String              { return m.Network }
+func modelGetOwnIPAddress(m model) types.String         { return m.OwnIPAddress }
+func modelGetR0KH(m model) types.List                   { return m.R0KH }
+func modelGetR1KH(m model) types.List                   { return m.R1KH }
+func modelGetSSID(m model) types.String                 { return m.SSID }
+func modelGetWDS(m model) types.Bool                    { return m.WDS }
+
+func modelSetAccountingPort(m *model, value types.Int64)        { m.AccountingPort = value }
+func modelSetAccountingSecret(m *model, value types.String)     { m.AccountingSecret = value }
+func modelSetAccountingServer(m *model, value types.String)     { m.AccountingServer = value }
+func modelSetAuthenticationPort(m *model, value types.Int64)    { m.AuthenticationPort = value }
+func modelSetAuthenticationSecret(m *model, value types.String) { m.AuthenticationSecret = value }
+func modelSetAuthenticationServer(m *model, value types.String) { m.AuthenticationServer = value }
+func modelSetBSSID(m *model, value types.String)                { m.BSSID = value }
+func modelSetBSSTransition(m *model, value types.Bool)          { m.BSSTransition = value }
+func modelSetDevice(m *model, value types.String)               { m.Device = value }
+func modelSetDynamicVLAN(m *model, value types.Int64)           { m.DynamicVLAN = value }
+func modelSetEncryptionMethod(m *model, value types.String)     { m.EncryptionMethod = value }
+func modelSetExtraOptions(m *model, value types.Map)            { m.ExtraOptions = value }
+func modelSetFTOverDS(m *model, value types.Bool)               { m.FTOverDS = value }
+func modelSetFTPSKGenerateLocal(m *model, value types.Bool)     { m.FTPSKGenerateLocal = value }
+func modelSetId(m *model, value types.String)                   { m.Id = value }
+func modelSetIEEE80211K(m *model, value types.Bool)             { m.IEEE80211K = value }
+func modelSetIEEE80211R(m *model, value types.Bool)             { m.IEEE80211R = value }
+func modelSetIEEE80211V(m *model, value types.Bool)             { m.IEEE80211V = value }
+func modelSetIsolateClients(m *model, value types.Bool)         { m.IsolateClients = value }
+func modelSetKey(m *model, value types.String)                  { m.Key = value }
+func modelSetKRACKWorkaround(m *model, value types.Bool)        { m.KRACKWorkaround = value }
+func modelSetMeshForwarding(m *model, value types.Bool)         { m.MeshForwarding = value }
+func modelSetMeshId(m *model, value types.String)               { m.MeshId = value }
+func modelSetMeshRSSIThreshold(m *model, value types.Int64)     { m.MeshRSSIThreshold = value }
+func modelSetMobilityDomain(m *model, value types.String)       { m.MobilityDomain = value }
+func modelSetMode(m *model, value types.String)                 { m.Mode = value }
+func modelSetNASIdentifier(m *model, value types.String)        { m.NASIdentifier = value }
+func modelSetNetwork(m *model, value types.String)              { m.Network = value }
+func modelSetOwnIPAddress(m *model, value types.String)         { m.OwnIPAddress = value }
+func modelSetR0KH(m *model, value types.List)                   { m.R0KH = value }
+func modelSetR1KH(m *model, value types.List)                   { m.R1KH = value }
+func modelSetSSID(m *model, value types.String)                 { m.SSID = value }
+func modelSetWDS(m *model, value types.Bool)                    { m.WDS = value }
+
+// enterpriseEncryptionConfigValidators require a RADIUS server and forbid a key for each of the given encryption methods.
+func enterpriseEncryptionConfigValidators(
+	methods []string,
+) []resource.ConfigValidator {
+	validators := []resource.ConfigValidator{}
+	for _, method := range methods {
+		validators = append(
+			validators,
+			lucirpcglue.WhenAttributeEqualString(
+				path.MatchRoot(encryptionMethodAttribute),
+				method,
+				lucirpcglue.AtLeastOneOf(
+					path.MatchRoot(authenticationServerAttribute),
+				),
+				lucirpcglue.AtLeastOneOf(
+					path.MatchRoot(authenticationSecretAttribute),
+				),
+				lucirpcglue.NoneOf(
+					path.MatchRoot(keyAttribute),
+				),
+			),
+		)
+	}
+
+	return validators
 }
 
-func modelGetDevice(m model) types.String           { return m.Device }
-func modelGetEncryptionMethod(m model) types.String { return m.EncryptionMethod }
-func modelGetId(m model) types.String               { return m.Id }
-func modelGetIsolateClients(m model) types.Bool     { return m.IsolateClients }
-func modelGetKey(m model) types.String              { return m.Key }
-func modelGetKRACKWorkaround(m model) types.Bool    { return m.KRACKWorkaround }
-func modelGetMode(m model) types.String             { return m.Mode }
-func modelGetNetwork(m model) types.String          { return m.Network }
-func modelGetSSID(m model) types.String             { return m.SSID }
-
-func modelSetDevice(m *model, value types.String)           { m.Device = value }
-func modelSetEncryptionMethod(m *model, value types.String) { m.EncryptionMethod = value }
-func modelSetId(m *model, value types.String)               { m.Id = value }
-func modelSetIsolateClients(m *model, value types.Bool)     { m.IsolateClients = value }
-func modelSetKey(m *model, value types.String)              { m.Key = value }
-func modelSetKRACKWorkaround(m *model, value types.Bool)    { m.KRACKWorkaround = value }
-func modelSetMode(m *model, value types.String)             { m.Mode = value }
-func modelSetNetwork(m *model, value types.String)          { m.Network = value }
-func modelSetSSID(m *model, value types.String)             { m.SSID = value }
+// requiresEncryptionMethodInt64 returns one validator per encryption method.
+// Combine them with `int64validator.AnyWithAllWarnings` to allow any of the methods.
+func requiresEncryptionMethodInt64(
+	methods []string,
+) []validator.Int64 {
+	validators := []validator.Int64{}
+	for _, method := range methods {
+		validators = append(
+			validators,
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(encryptionMethodAttribute),
+				method,
+			),
+		)
+	}
+
+	return validators
+}
+
+// requiresEncryptionMethodString returns one validator per encryption method.
+// Combine them with `stringvalidator.AnyWithAllWarnings` to allow any of the methods.
+func requiresEncryptionMethodString(
+	methods []string,
+) []validator.String {
+	validators := []validator.String{}
+	for _, method := range methods {
+		validators = append(
+			validators,
+			lucirpcglue.RequiresAttributeEqualString(
+				path.MatchRoot(encryptionMethodAttribute),
+				method,
+			),
+		)
+	}
+
+	return validators
+}
@@ -5,6 +5,7 @@ package wifiiface_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -126,3 +127,652 @@ resource "openwrt_wireless_wifi_iface" "testing" {
 		updateAndReadResource,
 	)
 }
+
+func TestResourceAPWithBSSIDAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	bssid = "00:11:22:33:44:55"
+	device = "device-testing"
+	id = "testing"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceAPWithoutSSIDAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	id = "testing"
+	mode = "ap"
+	network = "network-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceEnterpriseAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	acct_server = "192.0.2.10"
+	acct_secret = "accounting-secret"
+	auth_port = 1812
+	auth_secret = "authentication-secret"
+	auth_server = "192.0.2.10"
+	device = "device-testing"
+	dynamic_vlan = 1
+	encryption = "wpa2"
+	id = "testing"
+	mode = "ap"
+	nasid = "office"
+	network = "network-testing"
+	ownip = "192.0.2.1"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "acct_secret", "accounting-secret"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "acct_server", "192.0.2.10"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "auth_port", "1812"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "auth_secret", "authentication-secret"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "auth_server", "192.0.2.10"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "dynamic_vlan", "1"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "encryption", "wpa2"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_iface.testing", "key"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "nasid", "office"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ownip", "192.0.2.1"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_iface.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	auth_secret = "authentication-secret"
+	auth_server = "192.0.2.20"
+	device = "device-testing"
+	encryption = "wpa3"
+	id = "testing"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_iface.testing", "acct_server"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "auth_server", "192.0.2.20"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_iface.testing", "dynamic_vlan"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "encryption", "wpa3"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceEnterpriseWithKeyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	auth_secret = "authentication-secret"
+	auth_server = "192.0.2.10"
+	device = "device-testing"
+	encryption = "wpa2"
+	id = "testing"
+	key = "password"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceEnterpriseWithoutServerAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	auth_secret = "authentication-secret"
+	device = "device-testing"
+	encryption = "wpa2"
+	id = "testing"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceFastTransitionAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	bss_transition = true
+	device = "device-testing"
+	encryption = "psk2"
+	ft_over_ds = false
+	id = "testing"
+	ieee80211k = true
+	ieee80211r = true
+	ieee80211v = true
+	key = "password"
+	mobility_domain = "4f57"
+	mode = "ap"
+	nasid = "ap1"
+	network = "network-testing"
+	r0kh = [
+		"00:11:22:33:44:55,ap1,00112233445566778899aabbccddeeff",
+		"00:11:22:33:44:66,ap2,00112233445566778899aabbccddeeff",
+	]
+	r1kh = [
+		"00:11:22:33:44:55,00:11:22:33:44:55,00112233445566778899aabbccddeeff",
+		"00:11:22:33:44:66,00:11:22:33:44:66,00112233445566778899aabbccddeeff",
+	]
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "bss_transition", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ft_over_ds", "false"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ieee80211k", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ieee80211r", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ieee80211v", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mobility_domain", "4f57"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "nasid", "ap1"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "r0kh.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "r0kh.0", "00:11:22:33:44:55,ap1,00112233445566778899aabbccddeeff"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "r1kh.#", "2"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "r1kh.1", "00:11:22:33:44:66,00:11:22:33:44:66,00112233445566778899aabbccddeeff"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_iface.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	encryption = "psk2"
+	ft_psk_generate_local = true
+	id = "testing"
+	ieee80211r = true
+	key = "password"
+	mobility_domain = "4f57"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ft_psk_generate_local", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ieee80211r", "true"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_iface.testing", "nasid"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_iface.testing", "r0kh"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_iface.testing", "r1kh"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceFastTransitionOptionsWithoutIEEE80211RAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	encryption = "psk2"
+	id = "testing"
+	key = "password"
+	mobility_domain = "4f57"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceInvalidMobilityDomainAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	encryption = "psk2"
+	id = "testing"
+	ieee80211r = true
+	key = "password"
+	mobility_domain = "4f5"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceMeshAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	encryption = "sae"
+	id = "testing"
+	key = "password"
+	mesh_fwding = true
+	mesh_id = "mesh-testing"
+	mesh_rssi_threshold = -80
+	mode = "mesh"
+	network = "network-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mesh_fwding", "true"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mesh_id", "mesh-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mesh_rssi_threshold", "-80"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mode", "mesh"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_iface.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	id = "testing"
+	mesh_fwding = false
+	mesh_id = "mesh-testing"
+	mode = "mesh"
+	network = "network-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mesh_fwding", "false"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mesh_id", "mesh-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mode", "mesh"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceMeshWithWDSAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	id = "testing"
+	mesh_id = "mesh-testing"
+	mode = "mesh"
+	network = "network-testing"
+	wds = true
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceMeshWithoutMeshIdAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	id = "testing"
+	mode = "mesh"
+	network = "network-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceNoEncryptionWithKeyAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	encryption = "none"
+	id = "testing"
+	key = "password"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourcePSKWithRADIUSAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	auth_secret = "authentication-secret"
+	auth_server = "192.0.2.10"
+	device = "device-testing"
+	encryption = "psk2"
+	id = "testing"
+	key = "password"
+	mode = "ap"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
+
+func TestResourceSTAAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	bssid = "00:11:22:33:44:55"
+	device = "device-testing"
+	encryption = "psk2"
+	id = "testing"
+	key = "password"
+	mode = "sta"
+	network = "network-testing"
+	ssid = "ssid-testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "bssid", "00:11:22:33:44:55"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mode", "sta"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ssid", "ssid-testing"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_iface.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_iface" "testing" {
+	device = "device-testing"
+	encryption = "psk2"
+	id = "testing"
+	key = "password"
+	mode = "sta"
+	network = "network-testing"
+	ssid = "ssid-testing"
+	wds = true
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "mode", "sta"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "ssid", "ssid-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_iface.testing", "wds", "true"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
@@ -0,0 +1,136 @@
+package wifistation
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	ifaceAttribute            = "iface"
+	ifaceAttributeDescription = "Wireless network the passphrase is accepted on. This name is what the wireless network is known as in UCI, or the `id` field of an `openwrt_wireless_wifi_iface`. If unset, the passphrase is accepted on every PSK wireless network."
+	ifaceUCIOption            = "iface"
+
+	keyAttribute            = "key"
+	keyAttributeDescription = "The pre-shared passphrase of the station. The clear text key has to be 8-63 characters long."
+	keyUCIOption            = "key"
+
+	macAddressAttribute            = "mac"
+	macAddressAttributeDescription = `MAC address of the station (e.g. "00:11:22:33:44:55"). If unset, any station using the passphrase matches.`
+	macAddressUCIOption            = "mac"
+
+	schemaDescription = "A passphrase and VLAN for a wireless client, so each client can have its own passphrase without a RADIUS server. Available since OpenWrt 22.03."
+	schemaVersion     = 0
+
+	uciConfig = "wireless"
+	uciType   = "wifi-station"
+
+	vidAttribute            = "vid"
+	vidAttributeDescription = "The VLAN id the station is assigned to. This is the `vid` of an `openwrt_wireless_wifi_vlan`. Must be in the range: `[1, 4094]`. If unset, the station is not assigned to a VLAN."
+	vidUCIOption            = "vid"
+)
+
+var (
+	ifaceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ifaceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIface, ifaceAttribute, ifaceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetIface, ifaceAttribute, ifaceUCIOption),
+	}
+
+	keySchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       keyAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetKey, keyAttribute, keyUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		Sensitive:         true,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetKey, keyAttribute, keyUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(8, 63),
+		},
+	}
+
+	macAddressSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       macAddressAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetMACAddress, macAddressAttribute, macAddressUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetMACAddress, macAddressAttribute, macAddressUCIOption),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile("^([[:xdigit:]][[:xdigit:]]:){5}[[:xdigit:]][[:xdigit:]]$"),
+				`must be a valid MAC address (e.g. "00:11:22:33:44:55")`,
+			),
+		},
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		ifaceAttribute:                    ifaceSchemaAttribute,
+		keyAttribute:                      keySchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		macAddressAttribute:               macAddressSchemaAttribute,
+		vidAttribute:                      vidSchemaAttribute,
+	}
+
+	vidSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       vidAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetVID, vidAttribute, vidUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetVID, vidAttribute, vidUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 4094),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Iface        types.String `tfsdk:"iface"`
+	Key          types.String `tfsdk:"key"`
+	MACAddress   types.String `tfsdk:"mac"`
+	VID          types.Int64  `tfsdk:"vid"`
+}
+
+func modelGetExtraOptions(m model) types.Map  { return m.ExtraOptions }
+func modelGetId(m model) types.String         { return m.Id }
+func modelGetIface(m model) types.String      { return m.Iface }
+func modelGetKey(m model) types.String        { return m.Key }
+func modelGetMACAddress(m model) types.String { return m.MACAddress }
+func modelGetVID(m model) types.Int64         { return m.VID }
+
+func modelSetExtraOptions(m *model, value types.Map)  { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)         { m.Id = value }
+func modelSetIface(m *model, value types.String)      { m.Iface = value }
+func modelSetKey(m *model, value types.String)        { m.Key = value }
+func modelSetMACAddress(m *model, value types.String) { m.MACAddress = value }
+func modelSetVID(m *model, value types.Int64)         { m.VID = value }
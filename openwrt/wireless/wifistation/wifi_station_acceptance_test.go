@@ -0,0 +1,178 @@
+//go:build acceptance.test
+
+package wifistation_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	client, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	options := lucirpc.Options{
+		"iface": lucirpc.String("iface-testing"),
+		"key":   lucirpc.String("password"),
+		"mac":   lucirpc.String("00:11:22:33:44:55"),
+		"vid":   lucirpc.Integer(100),
+	}
+	ok, err := client.CreateSection(ctx, "wireless", "wifi-station", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_wifi_station" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_station.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_station.testing", "iface", "iface-testing"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_station.testing", "key", "password"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_station.testing", "mac", "00:11:22:33:44:55"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_station.testing", "vid", "100"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_station" "testing" {
+	id = "testing"
+	key = "password"
+	mac = "00:11:22:33:44:55"
+	vid = 100
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_station.testing", "iface"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "key", "password"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "mac", "00:11:22:33:44:55"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "vid", "100"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_station.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_station" "testing" {
+	id = "testing"
+	iface = "iface-testing"
+	key = "password2"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "iface", "iface-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_station.testing", "key", "password2"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_station.testing", "mac"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_station.testing", "vid"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceAnonymousAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_station" "testing" {
+	key = "password"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_wireless_wifi_station.testing", "id", regexp.MustCompile("^wifi_station_[[:xdigit:]]{8}$")),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+	)
+}
+
+func TestResourceInvalidMACAddressAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_station" "testing" {
+	id = "testing"
+	key = "password"
+	mac = "00-11-22-33-44-55"
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}
@@ -0,0 +1,127 @@
+package wifivlan
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"github.com/joneshf/terraform-provider-openwrt/openwrt/internal/lucirpcglue"
+)
+
+const (
+	ifaceAttribute            = "iface"
+	ifaceAttributeDescription = "Wireless network the VLAN applies to. This name is what the wireless network is known as in UCI, or the `id` field of an `openwrt_wireless_wifi_iface`. If unset, the VLAN applies to every wireless network."
+	ifaceUCIOption            = "iface"
+
+	nameAttribute            = "name"
+	nameAttributeDescription = "Name of the VLAN. It is used for the name of the VLAN device created for each wireless network."
+	nameUCIOption            = "name"
+
+	networkAttribute            = "network"
+	networkAttributeDescription = "Network interface the VLAN device is attached to. This name is what the interface is known as in UCI, or the `id` field in Terraform."
+	networkUCIOption            = "network"
+
+	schemaDescription = "A VLAN that wireless clients are assigned to, either by a RADIUS server or by the `vid` of an `openwrt_wireless_wifi_station`. Available since OpenWrt 22.03."
+	schemaVersion     = 0
+
+	uciConfig = "wireless"
+	uciType   = "wifi-vlan"
+
+	vidAttribute            = "vid"
+	vidAttributeDescription = "The VLAN id. Must be in the range: `[1, 4094]`."
+	vidUCIOption            = "vid"
+)
+
+var (
+	ifaceSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       ifaceAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetIface, ifaceAttribute, ifaceUCIOption),
+		ResourceExistence: lucirpcglue.NoValidation,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetIface, ifaceAttribute, ifaceUCIOption),
+	}
+
+	nameSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       nameAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetName, nameAttribute, nameUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetName, nameAttribute, nameUCIOption),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	networkSchemaAttribute = lucirpcglue.StringSchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       networkAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionString(modelSetNetwork, networkAttribute, networkUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionString(modelGetNetwork, networkAttribute, networkUCIOption),
+	}
+
+	schemaAttributes = map[string]lucirpcglue.SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		ifaceAttribute:                    ifaceSchemaAttribute,
+		lucirpcglue.ExtraOptionsAttribute: lucirpcglue.ExtraOptionsSchemaAttribute(modelGetExtraOptions, modelSetExtraOptions),
+		lucirpcglue.IdAttribute:           lucirpcglue.OptionalIdSchemaAttribute(modelGetId, modelSetId),
+		nameAttribute:                     nameSchemaAttribute,
+		networkAttribute:                  networkSchemaAttribute,
+		vidAttribute:                      vidSchemaAttribute,
+	}
+
+	vidSchemaAttribute = lucirpcglue.Int64SchemaAttribute[model, lucirpc.Options, lucirpc.Options]{
+		Description:       vidAttributeDescription,
+		ReadResponse:      lucirpcglue.ReadResponseOptionInt64(modelSetVID, vidAttribute, vidUCIOption),
+		ResourceExistence: lucirpcglue.Required,
+		UpsertRequest:     lucirpcglue.UpsertRequestOptionInt64(modelGetVID, vidAttribute, vidUCIOption),
+		Validators: []validator.Int64{
+			int64validator.Between(1, 4094),
+		},
+	}
+)
+
+func NewDataSource() datasource.DataSource {
+	return lucirpcglue.NewDataSource(
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		uciConfig,
+		uciType,
+	)
+}
+
+func NewResource() resource.Resource {
+	return lucirpcglue.NewResource(
+		nil,
+		modelGetId,
+		schemaAttributes,
+		schemaDescription,
+		schemaVersion,
+		nil,
+		uciConfig,
+		uciType,
+	)
+}
+
+type model struct {
+	ExtraOptions types.Map    `tfsdk:"extra_options"`
+	Id           types.String `tfsdk:"id"`
+	Iface        types.String `tfsdk:"iface"`
+	Name         types.String `tfsdk:"name"`
+	Network      types.String `tfsdk:"network"`
+	VID          types.Int64  `tfsdk:"vid"`
+}
+
+func modelGetExtraOptions(m model) types.Map { return m.ExtraOptions }
+func modelGetId(m model) types.String        { return m.Id }
+func modelGetIface(m model) types.String     { return m.Iface }
+func modelGetName(m model) types.String      { return m.Name }
+func modelGetNetwork(m model) types.String   { return m.Network }
+func modelGetVID(m model) types.Int64        { return m.VID }
+
+func modelSetExtraOptions(m *model, value types.Map) { m.ExtraOptions = value }
+func modelSetId(m *model, value types.String)        { m.Id = value }
+func modelSetIface(m *model, value types.String)     { m.Iface = value }
+func modelSetName(m *model, value types.String)      { m.Name = value }
+func modelSetNetwork(m *model, value types.String)   { m.Network = value }
+func modelSetVID(m *model, value types.Int64)        { m.VID = value }
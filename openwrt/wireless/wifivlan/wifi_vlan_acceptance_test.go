@@ -0,0 +1,183 @@
+//go:build acceptance.test
+
+package wifivlan_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/joneshf/terraform-provider-openwrt/internal/acceptancetest"
+	"github.com/joneshf/terraform-provider-openwrt/lucirpc"
+	"gotest.tools/v3/assert"
+)
+
+func TestDataSourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	client, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+	options := lucirpc.Options{
+		"iface":   lucirpc.String("iface-testing"),
+		"name":    lucirpc.String("iot"),
+		"network": lucirpc.String("network-testing"),
+		"vid":     lucirpc.Integer(100),
+	}
+	ok, err := client.CreateSection(ctx, "wireless", "wifi-vlan", "testing", options)
+	assert.NilError(t, err)
+	assert.Check(t, ok)
+
+	readDataSource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+data "openwrt_wireless_wifi_vlan" "testing" {
+	id = "testing"
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_vlan.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_vlan.testing", "iface", "iface-testing"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_vlan.testing", "name", "iot"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_vlan.testing", "network", "network-testing"),
+			resource.TestCheckResourceAttr("data.openwrt_wireless_wifi_vlan.testing", "vid", "100"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		readDataSource,
+	)
+}
+
+func TestResourceAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_vlan" "testing" {
+	id = "testing"
+	name = "iot"
+	network = "network-testing"
+	vid = 100
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "id", "testing"),
+			resource.TestCheckNoResourceAttr("openwrt_wireless_wifi_vlan.testing", "iface"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "name", "iot"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "network", "network-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "vid", "100"),
+		),
+	}
+	importValidation := resource.TestStep{
+		ImportState:       true,
+		ImportStateVerify: true,
+		ResourceName:      "openwrt_wireless_wifi_vlan.testing",
+	}
+	updateAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_vlan" "testing" {
+	id = "testing"
+	iface = "iface-testing"
+	name = "guest"
+	network = "network-testing"
+	vid = 200
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "id", "testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "iface", "iface-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "name", "guest"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "network", "network-testing"),
+			resource.TestCheckResourceAttr("openwrt_wireless_wifi_vlan.testing", "vid", "200"),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+		importValidation,
+		updateAndReadResource,
+	)
+}
+
+func TestResourceAnonymousAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	createAndReadResource := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_vlan" "testing" {
+	name = "iot"
+	network = "network-testing"
+	vid = 100
+}
+`,
+			providerBlock,
+		),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestMatchResourceAttr("openwrt_wireless_wifi_vlan.testing", "id", regexp.MustCompile("^wifi_vlan_[[:xdigit:]]{8}$")),
+		),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		createAndReadResource,
+	)
+}
+
+func TestResourceInvalidVIDAcceptance(t *testing.T) {
+	ctx := context.Background()
+	_, providerBlock := runOpenWrtServerWithWireless(
+		ctx,
+		*dockerPool,
+		t,
+	)
+
+	step := resource.TestStep{
+		Config: fmt.Sprintf(`
+%s
+
+resource "openwrt_wireless_wifi_vlan" "testing" {
+	id = "testing"
+	name = "iot"
+	network = "network-testing"
+	vid = 4095
+}
+`,
+			providerBlock,
+		),
+		ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+	}
+
+	acceptancetest.TerraformSteps(
+		t,
+		step,
+	)
+}